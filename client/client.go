@@ -7,16 +7,26 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
 )
 
-const TokenType = "ephemeral"
+const (
+	TokenType = "ephemeral"
+
+	StackTypeVictoria = "victoria"
+	StackTypeClassic  = "classic"
+)
 
 type ACSProvider struct {
 	Client *v2.ClientInterface
 	Stack  v2.Stack
+	// StackType is the experience of the stack (victoria or classic) used to route requests to the right endpoints
+	StackType string
 }
 
 type LoginResult struct {
@@ -30,6 +40,18 @@ type LoginResult struct {
 	NotBefore string `json:"notBefore"`
 }
 
+type errUnsupportedStackType struct {
+	operation string
+	stackType string
+}
+
+func (e errUnsupportedStackType) Error() string {
+	if e.stackType == "" {
+		return fmt.Sprintf("%s is not supported, the experience of the stack could not be determined", e.operation)
+	}
+	return fmt.Sprintf("%s is not supported on %s experience stacks", e.operation, e.stackType)
+}
+
 type errInvalidAuth struct {
 	field string
 }
@@ -131,3 +153,75 @@ func GenerateToken(ctx context.Context, clientInterface v2.ClientInterface, user
 
 	return loginResult.Token, nil
 }
+
+// GetStackType describes the stack and returns its experience type (victoria or classic), retrying while ACS is rate limiting
+func GetStackType(ctx context.Context, clientInterface v2.ClientInterface, stack string) (string, error) {
+	waitStackRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, stackStatusRead(ctx, clientInterface, stack))
+
+	output, err := waitStackRead.WaitForStateContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	stackStatus, ok := output.(*v2.StackStatus)
+	if !ok || stackStatus == nil {
+		return "", errors.New("failed to describe stack: empty response")
+	}
+
+	if stackStatus.Infrastructure.StackType == nil {
+		return "", errors.New("stack type missing from describe stack response")
+	}
+
+	stackType := strings.ToLower(*stackStatus.Infrastructure.StackType)
+	if stackType != StackTypeVictoria && stackType != StackTypeClassic {
+		return "", fmt.Errorf("unknown stack type (%s)", *stackStatus.Infrastructure.StackType)
+	}
+	return stackType, nil
+}
+
+// stackStatusRead returns StateRefreshFunc that describes the stack
+func stackStatusRead(ctx context.Context, clientInterface v2.ClientInterface, stack string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := clientInterface.DescribeStack(ctx, v2.Stack(stack))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		tflog.Info(ctx, fmt.Sprintf("Describe stack request ID %s", resp.Header.Get("X-REQUEST-ID")))
+
+		statusText := http.StatusText(resp.StatusCode)
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusFailedDependency {
+			// return a non-nil value so the wait keeps polling while the request is retryable
+			return resp, statusText, nil
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, statusText, &resource.UnexpectedStateError{
+				State:         statusText,
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     fmt.Errorf("failed to describe stack: %v", errors.New(string(bodyBytes))),
+			}
+		}
+
+		// the stack status is nested under the status key of the DescribeStack response body
+		var stackInfo struct {
+			Status *v2.StackStatus `json:"status,omitempty"`
+		}
+		if err = json.Unmarshal(bodyBytes, &stackInfo); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: fmt.Errorf("unmarshal error: %v", err)}
+		}
+		return stackInfo.Status, statusText, nil
+	}
+}
+
+// CheckStackTypeSupported returns an error if the operation is not available on the experience of the stack
+func CheckStackTypeSupported(operation string, stackType string, supportedStackTypes ...string) error {
+	for _, supported := range supportedStackTypes {
+		if stackType == supported {
+			return nil
+		}
+	}
+	return &errUnsupportedStackType{operation: operation, stackType: stackType}
+}
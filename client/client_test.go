@@ -243,3 +243,108 @@ func genInvalidTokenResp(code int) *http.Response {
 	}
 	return recorder.Result()
 }
+
+func TestGetStackType(t *testing.T) {
+	mockClient := &mocks.ClientInterface{}
+	assert := assert.New(t)
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		stackType, err := client.GetStackType(context.TODO(), mockClient, mockStack)
+		assert.Error(err)
+		assert.Equal(stackType, "")
+	})
+
+	t.Run("with victoria stack and http response 200", func(_ *testing.T) {
+		mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, "Victoria"), nil).Once()
+		stackType, err := client.GetStackType(context.TODO(), mockClient, mockStack)
+		assert.NoError(err)
+		assert.Equal(stackType, client.StackTypeVictoria)
+	})
+
+	t.Run("with classic stack and http response 200", func(_ *testing.T) {
+		mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, "Classic"), nil).Once()
+		stackType, err := client.GetStackType(context.TODO(), mockClient, mockStack)
+		assert.NoError(err)
+		assert.Equal(stackType, client.StackTypeClassic)
+	})
+
+	t.Run("with unknown stack type", func(_ *testing.T) {
+		mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, "unknown"), nil).Once()
+		stackType, err := client.GetStackType(context.TODO(), mockClient, mockStack)
+		assert.ErrorContains(err, "unknown stack type")
+		assert.Equal(stackType, "")
+	})
+
+	t.Run("with missing stack type", func(_ *testing.T) {
+		mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, ""), nil).Once()
+		stackType, err := client.GetStackType(context.TODO(), mockClient, mockStack)
+		assert.ErrorContains(err, "stack type missing")
+		assert.Equal(stackType, "")
+	})
+
+	t.Run("with http response 429 then 200", func(_ *testing.T) {
+		mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(429, ""), nil).Once()
+		mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, "Victoria"), nil).Once()
+		stackType, err := client.GetStackType(context.TODO(), mockClient, mockStack)
+		assert.NoError(err)
+		assert.Equal(stackType, client.StackTypeVictoria)
+	})
+
+	// http unexpected status codes
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, unexpectedStatusCode := range []int{101, 400, 401, 403, 404, 409, 500, 501, 503} {
+			t.Run(fmt.Sprintf("with unexpected status %v", unexpectedStatusCode), func(_ *testing.T) {
+				mockClient.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(unexpectedStatusCode, ""), nil).Once()
+				stackType, err := client.GetStackType(context.TODO(), mockClient, mockStack)
+				assert.Error(err)
+				assert.Equal(stackType, "")
+			})
+		}
+	})
+}
+
+func TestCheckStackTypeSupported(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("with supported stack type", func(_ *testing.T) {
+		err := client.CheckStackTypeSupported("mock operation", client.StackTypeClassic, client.StackTypeVictoria, client.StackTypeClassic)
+		assert.NoError(err)
+	})
+
+	t.Run("with unsupported stack type", func(_ *testing.T) {
+		err := client.CheckStackTypeSupported("mock operation", client.StackTypeClassic, client.StackTypeVictoria)
+		assert.EqualError(err, "mock operation is not supported on classic experience stacks")
+	})
+
+	t.Run("with unknown stack type", func(_ *testing.T) {
+		err := client.CheckStackTypeSupported("mock operation", "", client.StackTypeVictoria)
+		assert.ErrorContains(err, "mock operation is not supported")
+	})
+}
+
+func genStackResp(code int, stackType string) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		stackStatus := v2.StackStatus{}
+		if stackType != "" {
+			stackStatus.Infrastructure.StackType = &stackType
+		}
+
+		b, _ = json.Marshal(&struct {
+			Status *v2.StackStatus `json:"status,omitempty"`
+		}{Status: &stackStatus})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
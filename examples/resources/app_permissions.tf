@@ -0,0 +1,5 @@
+resource "scp_app_permissions" "search" {
+  app   = "search"
+  read  = ["sc_admin", "user", scp_roles.role-1.name]
+  write = ["sc_admin"]
+}
@@ -0,0 +1,14 @@
+resource "scp_apps" "splunk-ta-aws" {
+  splunkbase_id       = "1876"
+  version             = "7.1.0"
+  acs_licensing_ack   = "https://www.splunk.com/en_us/legal/splunk-general-terms.html"
+  splunkbase_username = var.splunkbase_username
+  splunkbase_password = var.splunkbase_password
+}
+
+resource "scp_apps" "my-private-app" {
+  package_path        = "${path.module}/packages/my_private_app.tar.gz"
+  acs_legal_ack       = "Y"
+  appinspect_username = var.appinspect_username
+  appinspect_password = var.appinspect_password
+}
@@ -0,0 +1,15 @@
+data "scp_emek_policy" "emek" {
+  legal_ack = "Y"
+}
+
+resource "aws_kms_key" "emek" {
+  description = "Splunk Cloud Platform EMEK key"
+  policy      = data.scp_emek_policy.emek.policy
+}
+
+resource "scp_emek_key" "emek" {
+  key_arn = aws_kms_key.emek.arn
+
+  # Set to true to allow changing key_arn, which rotates the encryption key of the stack
+  allow_key_rotation = false
+}
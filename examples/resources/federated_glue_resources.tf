@@ -0,0 +1,33 @@
+resource "scp_federated_glue_resources" "security_lake" {
+  table {
+    database        = "security_lake"
+    name            = "cloudtrail"
+    file_format     = "parquet"
+    location_prefix = "s3://aws-security-data-lake-us-east-1/ext/cloudtrail/"
+    source_type     = "aws:cloudtrail"
+
+    partition_projection {
+      account_ids = ["123456789012"]
+      regions     = ["us-east-1", "us-west-2"]
+      time_unit   = "days"
+      time_range  = "2024/01/01,NOW"
+    }
+  }
+
+  table {
+    database        = "security_lake"
+    name            = "vpc_flow"
+    file_format     = "parquet"
+    location_prefix = "s3://aws-security-data-lake-us-east-1/ext/vpc_flow/"
+    source_type     = "aws:vpcflow"
+
+    partition_projection {
+      account_ids = ["123456789012"]
+      regions     = ["us-east-1"]
+    }
+
+    extra {
+      partition_style = "hive"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+resource "scp_ip_allowlist_subnet" "search-api-vpn" {
+  feature = "search-api"
+  subnet  = "###.0.0.0/24"
+}
+
+resource "scp_ip_allowlist_subnet" "search-api-office" {
+  feature = "search-api"
+  subnet  = "##.0.10.6/32"
+}
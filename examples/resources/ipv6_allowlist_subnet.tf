@@ -0,0 +1,4 @@
+resource "scp_ipv6_allowlist_subnet" "search-api-vpn" {
+  feature = "search-api"
+  subnet  = "fe84:1ee:fe23:4637::/64"
+}
@@ -0,0 +1,5 @@
+resource "scp_ipv6_outbound_ports" "port-8089" {
+  port    = 8089
+  subnets = ["2001:db8::/64", "2001:db8:0:1::/64"]
+  reason  = "Federated search to remote deployment"
+}
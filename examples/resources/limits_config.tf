@@ -0,0 +1,7 @@
+resource "scp_limits_config" "search" {
+  stanza = "search"
+  settings = {
+    max_mem_usage_mb     = "400"
+    max_searches_per_cpu = "2"
+  }
+}
@@ -0,0 +1,6 @@
+resource "scp_maintenance_change_freeze" "q4_close" {
+  applies_to = "all"
+  start_date = "2024/12/20"
+  end_date   = "2025/01/06"
+  reason     = "Quarter end close"
+}
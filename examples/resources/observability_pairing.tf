@@ -0,0 +1,12 @@
+variable "o11y_access_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "scp_observability_pairing" "o11y" {
+  realm        = "us0"
+  access_token = var.o11y_access_token
+
+  # Set to true to enable centralized user and role management, which can not be disabled again
+  enable_centralized_rbac = true
+}
@@ -0,0 +1,10 @@
+resource "scp_outbound_ports" "port-8089" {
+  port    = 8089
+  subnets = ["###.0.0.0/24", "##.0.10.6/32"]
+  reason  = "Federated search to remote deployment"
+}
+
+resource "scp_outbound_ports" "port-9997" {
+  port    = 9997
+  subnets = ["###.0.0.0/24"]
+}
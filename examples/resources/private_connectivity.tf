@@ -0,0 +1,8 @@
+resource "scp_private_connectivity" "private" {
+  customer_account_ids = ["123456789012"]
+  features             = ["search", "ingest"]
+}
+
+output "private_endpoints" {
+  value = scp_private_connectivity.private.endpoints
+}
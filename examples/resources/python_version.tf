@@ -0,0 +1,3 @@
+resource "scp_python_version" "python" {
+  python_version = "3.9"
+}
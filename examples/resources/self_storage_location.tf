@@ -0,0 +1,12 @@
+resource "scp_self_storage_location" "archive" {
+  bucket_name = "mystack-ddss-archive"
+  folder      = "indexes"
+  title       = "archive"
+  description = "Archived index data"
+}
+
+resource "scp_indexes" "archived" {
+  name                     = "archived"
+  searchable_days          = 90
+  self_storage_bucket_path = scp_self_storage_location.archive.bucket_path
+}
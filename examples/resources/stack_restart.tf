@@ -0,0 +1,13 @@
+resource "scp_limits_config" "search" {
+  stanza = "search"
+  settings = {
+    max_searches_per_cpu = "2"
+  }
+}
+
+resource "scp_stack_restart" "restart" {
+  # Restart the stack whenever the limits change, if ACS reports a restart as required
+  triggers = {
+    search_limits = jsonencode(scp_limits_config.search.settings)
+  }
+}
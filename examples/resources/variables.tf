@@ -1,4 +1,21 @@
 variable "user-1-password" {
   description = "Password of the user-1 user"
   sensitive   = true
-}
\ No newline at end of file
+}
+variable "splunkbase_username" {
+  description = "Splunkbase username used to install splunkbase apps"
+}
+
+variable "splunkbase_password" {
+  description = "Splunkbase password used to install splunkbase apps"
+  sensitive   = true
+}
+
+variable "appinspect_username" {
+  description = "Splunk.com username used to validate private app packages with AppInspect"
+}
+
+variable "appinspect_password" {
+  description = "Splunk.com password used to validate private app packages with AppInspect"
+  sensitive   = true
+}
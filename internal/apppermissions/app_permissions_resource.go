@@ -0,0 +1,215 @@
+package apppermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	ResourceKey = "scp_app_permissions"
+
+	schemaKeyApp   = "app"
+	schemaKeyRead  = "read"
+	schemaKeyWrite = "write"
+)
+
+func appPermissionsResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyApp: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			Description: "The name (app ID) of the app to manage permissions of. Can not be updated after creation, " +
+				"if changed in config file terraform will propose a replacement.",
+		},
+		schemaKeyRead: {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Set:          hashRoleName,
+			AtLeastOneOf: []string{schemaKeyRead, schemaKeyWrite},
+			Description: "The roles that have read access to the app. If not set, read access of the app is not managed. " +
+				"An empty set revokes read access from all roles.",
+		},
+		schemaKeyWrite: {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Set:          hashRoleName,
+			AtLeastOneOf: []string{schemaKeyRead, schemaKeyWrite},
+			Description: "The roles that have write access to the app. If not set, write access of the app is not managed. " +
+				"An empty set revokes write access from all roles.",
+		},
+	}
+}
+
+func ResourceAppPermissions() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "App Permissions Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageApps " +
+			"for more latest, detailed information on attribute requirements and the ACS App Permissions API.",
+
+		CreateContext: resourceAppPermissionsCreate,
+		ReadContext:   resourceAppPermissionsRead,
+		UpdateContext: resourceAppPermissionsUpdate,
+		DeleteContext: resourceAppPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: appPermissionsResourceSchema(),
+	}
+}
+
+func resourceAppPermissionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appName := d.Get(schemaKeyApp).(string)
+
+	if diags := applyAppPermissions(ctx, d, m, appName); diags != nil {
+		return diags
+	}
+
+	// Set ID of app permissions resource to indicate app permissions are managed
+	d.SetId(appName)
+
+	tflog.Info(ctx, fmt.Sprintf("Created app permissions resource: %s\n", appName))
+
+	// Call readAppPermissions to set attributes of app permissions
+	return resourceAppPermissionsRead(ctx, d, m)
+}
+
+func resourceAppPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+	if err := client.CheckStackTypeSupported("app permissions management", acsProvider.StackType, client.StackTypeVictoria); err != nil {
+		return diag.FromErr(err)
+	}
+
+	appName := d.Id()
+
+	appPerms, err := WaitAppPermissionsRead(ctx, acsClient, stack, appName)
+	if err != nil {
+		// if app not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing app permissions from state. Not Found error while reading permissions of app (%s): %s.", appName, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading permissions of app (%s): %s", appName, err)
+	}
+
+	if err := d.Set(schemaKeyApp, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRead, appPerms.Perms.Read); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyWrite, appPerms.Perms.Write); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAppPermissionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appName := d.Id()
+
+	if diags := applyAppPermissions(ctx, d, m, appName); diags != nil {
+		return diags
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("updated app permissions resource: %s\n", appName))
+	return resourceAppPermissionsRead(ctx, d, m)
+}
+
+func resourceAppPermissionsDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// App permissions can not be deleted, the app keeps its current permissions and is only removed from terraform management
+	tflog.Info(ctx, fmt.Sprintf("removed app permissions resource from state, permissions of app (%s) are left unchanged\n", d.Id()))
+	return nil
+}
+
+// applyAppPermissions diffs the configured roles against the current permissions of the app and patches the roles that differ
+func applyAppPermissions(ctx context.Context, d *schema.ResourceData, m interface{}, appName string) diag.Diagnostics {
+	// use the meta value to retrieve client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+	if err := client.CheckStackTypeSupported("app permissions management", acsProvider.StackType, client.StackTypeVictoria); err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentPerms, err := WaitAppPermissionsRead(ctx, acsClient, stack, appName)
+	if err != nil {
+		return diag.Errorf("Error reading permissions of app (%s): %s", appName, err)
+	}
+
+	patchRequest := parseAppPermissionsRequest(d, *currentPerms)
+	if patchRequest.Read == nil && patchRequest.Write == nil {
+		tflog.Info(ctx, fmt.Sprintf("permissions of app (%s) are up to date\n", appName))
+		return nil
+	}
+
+	if err = WaitAppPermissionsUpdate(ctx, acsClient, stack, appName, patchRequest); err != nil {
+		return diag.Errorf("Error submitting request for permissions of app (%s) to be updated: %s", appName, err)
+	}
+
+	//Poll until permissions have been confirmed updated
+	if err = WaitVerifyAppPermissionsUpdate(ctx, acsClient, stack, appName, patchRequest); err != nil {
+		return diag.Errorf("Error waiting for permissions of app (%s) to be updated: %s", appName, err)
+	}
+
+	return nil
+}
+
+// parseAppPermissionsRequest returns a patch request containing only the configured roles that differ from the current permissions
+func parseAppPermissionsRequest(d *schema.ResourceData, currentPerms v2.AppPerms) v2.PatchPermissionsAppsJSONRequestBody {
+	patchRequest := v2.PatchPermissionsAppsJSONRequestBody{}
+
+	if isConfigured(d, schemaKeyRead) {
+		parsedData := utils.ParseSetValues(d.Get(schemaKeyRead))
+		if !IsRoleListEqual(&parsedData, currentPerms.Perms.Read) {
+			patchRequest.Read = &parsedData
+		}
+	}
+
+	if isConfigured(d, schemaKeyWrite) {
+		parsedData := utils.ParseSetValues(d.Get(schemaKeyWrite))
+		if !IsRoleListEqual(&parsedData, currentPerms.Perms.Write) {
+			patchRequest.Write = &parsedData
+		}
+	}
+
+	return patchRequest
+}
+
+// isConfigured returns true if the attribute is set in config, including an empty set that revokes all roles
+func isConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		_, ok := d.GetOk(key)
+		return ok
+	}
+	return !rawConfig.GetAttr(key).IsNull()
+}
+
+// hashRoleName hashes the normalized role name so that role names normalized by the ACS API do not cause a diff
+func hashRoleName(v interface{}) int {
+	return schema.HashString(NormalizeRoleName(v.(string)))
+}
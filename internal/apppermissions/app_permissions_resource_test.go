@@ -0,0 +1,65 @@
+package apppermissions_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+var (
+	// app installed on every stack
+	permissionsAppName = "search"
+	readRoles          = []string{"sc_admin", "user"}
+	readRolesUpdated   = []string{"sc_admin", "user", "power"}
+	writeRoles         = []string{"sc_admin"}
+)
+
+func resourcePrefix(resourceName string) string {
+	return fmt.Sprint("scp_app_permissions.", resourceName)
+}
+
+func TestAcc_SplunkCloudAppPermissions(t *testing.T) {
+	resourceName := resource.UniqueId()
+
+	appPermissionsResourceTest := []resource.TestStep{
+		// Set read and write roles of app
+		{
+			Config: testAccInstanceConfigAppPermissions(resourceName, readRoles, writeRoles),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "app", permissionsAppName),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "read.#", fmt.Sprint(len(readRoles))),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "write.#", fmt.Sprint(len(writeRoles))),
+			),
+		},
+		// Grant read access to another role
+		{
+			Config: testAccInstanceConfigAppPermissions(resourceName, readRolesUpdated, writeRoles),
+			Check:  resource.TestCheckResourceAttr(resourcePrefix(resourceName), "read.#", fmt.Sprint(len(readRolesUpdated))),
+		},
+		// Import app permissions
+		{
+			ResourceName:      resourcePrefix(resourceName),
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps:             appPermissionsResourceTest,
+	})
+}
+
+func testAccInstanceConfigAppPermissions(resourceName string, read []string, write []string) string {
+	readList, _ := json.Marshal(read)
+	writeList, _ := json.Marshal(write)
+	return fmt.Sprintf(`resource "scp_app_permissions" %[1]q {
+		app   = %[2]q
+		read  = %[3]s
+		write = %[4]s
+	}`, resourceName, permissionsAppName, readList, writeList)
+}
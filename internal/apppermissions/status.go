@@ -0,0 +1,142 @@
+package apppermissions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// AppPermissionsStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns app permissions response
+func AppPermissionsStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribePermissionsApps(ctx, stack, v2.AppName(appName))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var appPerms v2.AppPerms
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &appPerms); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &appPerms, status, nil
+	}
+}
+
+// AppPermissionsStatusUpdate returns StateRefreshFunc that makes PATCH request and checks if request was successful
+func AppPermissionsStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, patchRequest v2.PatchPermissionsAppsJSONRequestBody) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.PatchPermissionsApps(ctx, stack, v2.AppName(appName), patchRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// AppPermissionsStatusVerifyUpdate returns a StateRefreshFunc that makes a GET request and checks to see if the app permissions match those in patch request
+func AppPermissionsStatusVerifyUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, patchRequest v2.PatchPermissionsAppsJSONRequestBody) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribePermissionsApps(ctx, stack, v2.AppName(appName))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{LastError: errors.New(string(bodyBytes))}
+		}
+
+		var appPerms v2.AppPerms
+		updateComplete := false
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &appPerms); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			updateComplete = VerifyAppPermissionsUpdate(patchRequest, appPerms)
+		}
+
+		if updateComplete {
+			return &appPerms, status.UpdatedStatus, nil
+		}
+		return nil, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// VerifyAppPermissionsUpdate is a helper to verify that the roles in patch request match the roles in the app permissions response
+func VerifyAppPermissionsUpdate(patchRequest v2.PatchPermissionsAppsJSONRequestBody, appPerms v2.AppPerms) bool {
+	if patchRequest.Read != nil && !IsRoleListEqual(patchRequest.Read, appPerms.Perms.Read) {
+		return false
+	}
+	if patchRequest.Write != nil && !IsRoleListEqual(patchRequest.Write, appPerms.Perms.Write) {
+		return false
+	}
+	return true
+}
+
+// NormalizeRoleName returns the role name in the form the ACS API stores it, role names are compared case-insensitively
+func NormalizeRoleName(roleName string) string {
+	return strings.ToLower(strings.TrimSpace(roleName))
+}
+
+// IsRoleListEqual compares two lists of role names ignoring the element order, duplicates and role name normalization
+func IsRoleListEqual(inA *[]string, inB *[]string) bool {
+	a := normalizeRoleList(inA)
+	b := normalizeRoleList(inB)
+
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeRoleList(in *[]string) []string {
+	normalized := make([]string, 0)
+	if in == nil {
+		return normalized
+	}
+
+	seen := map[string]bool{}
+	for _, role := range *in {
+		role = NormalizeRoleName(role)
+		if !seen[role] {
+			seen[role] = true
+			normalized = append(normalized, role)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
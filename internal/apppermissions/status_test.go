@@ -0,0 +1,57 @@
+package apppermissions_test
+
+import (
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/apppermissions"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsRoleListEqual(t *testing.T) {
+	t.Run("with both lists nil", func(t *testing.T) {
+		assert.True(t, apppermissions.IsRoleListEqual(nil, nil))
+	})
+
+	t.Run("with different order", func(t *testing.T) {
+		assert.True(t, apppermissions.IsRoleListEqual(&[]string{"user", "sc_admin"}, &[]string{"sc_admin", "user"}))
+	})
+
+	t.Run("with role names normalized by the api", func(t *testing.T) {
+		assert.True(t, apppermissions.IsRoleListEqual(&[]string{"SC_Admin", " user "}, &[]string{"sc_admin", "user"}))
+	})
+
+	t.Run("with duplicate role names", func(t *testing.T) {
+		assert.True(t, apppermissions.IsRoleListEqual(&[]string{"user", "User"}, &[]string{"user"}))
+	})
+
+	t.Run("with different roles", func(t *testing.T) {
+		assert.False(t, apppermissions.IsRoleListEqual(&[]string{"user"}, &[]string{"sc_admin"}))
+		assert.False(t, apppermissions.IsRoleListEqual(&[]string{"user"}, nil))
+	})
+}
+
+func Test_VerifyAppPermissionsUpdate(t *testing.T) {
+	appPerms := v2.AppPerms{
+		Name: mockAppName,
+		Perms: v2.AppPermsProperties{
+			Read:  &mockReadRoles,
+			Write: &mockWriteRoles,
+		},
+	}
+
+	t.Run("with matching roles", func(t *testing.T) {
+		patchRequest := v2.PatchPermissionsAppsJSONRequestBody{Read: &[]string{"User", "sc_admin"}, Write: &[]string{"SC_ADMIN"}}
+		assert.True(t, apppermissions.VerifyAppPermissionsUpdate(patchRequest, appPerms))
+	})
+
+	t.Run("with only read roles in request", func(t *testing.T) {
+		patchRequest := v2.PatchPermissionsAppsJSONRequestBody{Read: &mockReadRoles}
+		assert.True(t, apppermissions.VerifyAppPermissionsUpdate(patchRequest, appPerms))
+	})
+
+	t.Run("with roles not yet updated", func(t *testing.T) {
+		patchRequest := v2.PatchPermissionsAppsJSONRequestBody{Write: &mockReadRoles}
+		assert.False(t, apppermissions.VerifyAppPermissionsUpdate(patchRequest, appPerms))
+	})
+}
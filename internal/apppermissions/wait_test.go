@@ -0,0 +1,154 @@
+package apppermissions_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/apppermissions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockAppName = "mock-app"
+	mockStack   = "mock-stack"
+)
+
+var (
+	unexpectedStatusCodes     = []int{400, 401, 403, 404, 409, 501, 503}
+	unexpectedStatusCodesPoll = []int{400, 401, 403, 404, 409, 501, 500, 503}
+
+	mockReadRoles    = []string{"sc_admin", "user"}
+	mockWriteRoles   = []string{"sc_admin"}
+	mockPatchRequest = v2.PatchPermissionsAppsJSONRequestBody{
+		Read:  &mockReadRoles,
+		Write: &mockWriteRoles,
+	}
+)
+
+func Test_WaitAppPermissionsRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(nil, errors.New("some error")).Once()
+		appPerms, err := apppermissions.WaitAppPermissionsRead(context.TODO(), client, mockStack, mockAppName)
+		assert.Error(t, err)
+		assert.Nil(t, appPerms)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(http.StatusOK, mockReadRoles, mockWriteRoles), nil).Once()
+		appPerms, err := apppermissions.WaitAppPermissionsRead(context.TODO(), client, mockStack, mockAppName)
+		assert.NoError(t, err)
+		assert.Equal(t, mockReadRoles, *appPerms.Perms.Read)
+		assert.Equal(t, mockWriteRoles, *appPerms.Perms.Write)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(http.StatusTooManyRequests, nil, nil), nil).Once()
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(http.StatusOK, mockReadRoles, mockWriteRoles), nil).Once()
+		appPerms, err := apppermissions.WaitAppPermissionsRead(context.TODO(), client, mockStack, mockAppName)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppName, appPerms.Name)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(code, nil, nil), nil).Once()
+				appPerms, err := apppermissions.WaitAppPermissionsRead(context.TODO(), client, mockStack, mockAppName)
+				assert.Error(t, err)
+				assert.Nil(t, appPerms)
+			})
+		}
+	})
+}
+
+func Test_WaitAppPermissionsUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), mockPatchRequest).Return(nil, errors.New("some error")).Once()
+		err := apppermissions.WaitAppPermissionsUpdate(context.TODO(), client, mockStack, mockAppName, mockPatchRequest)
+		assert.Error(t, err)
+	})
+
+	for _, code := range []int{http.StatusOK, http.StatusAccepted} {
+		t.Run(fmt.Sprintf("with http response %v", code), func(t *testing.T) {
+			client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), mockPatchRequest).Return(genAppPermsResp(code, mockReadRoles, mockWriteRoles), nil).Once()
+			err := apppermissions.WaitAppPermissionsUpdate(context.TODO(), client, mockStack, mockAppName, mockPatchRequest)
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), mockPatchRequest).Return(genAppPermsResp(code, nil, nil), nil).Once()
+				err := apppermissions.WaitAppPermissionsUpdate(context.TODO(), client, mockStack, mockAppName, mockPatchRequest)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyAppPermissionsUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with permissions updated", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(http.StatusOK, mockReadRoles, mockWriteRoles), nil).Once()
+		err := apppermissions.WaitVerifyAppPermissionsUpdate(context.TODO(), client, mockStack, mockAppName, mockPatchRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with permissions pending then updated", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(http.StatusOK, mockWriteRoles, mockWriteRoles), nil).Once()
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(http.StatusOK, mockReadRoles, mockWriteRoles), nil).Once()
+		err := apppermissions.WaitVerifyAppPermissionsUpdate(context.TODO(), client, mockStack, mockAppName, mockPatchRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodesPoll {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppPermsResp(code, nil, nil), nil).Once()
+				err := apppermissions.WaitVerifyAppPermissionsUpdate(context.TODO(), client, mockStack, mockAppName, mockPatchRequest)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+// genAppPermsResp returns a new response on every call since the body can only be read once
+func genAppPermsResp(code int, readRoles []string, writeRoles []string) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		appPerms := v2.AppPerms{
+			Name: mockAppName,
+			Perms: v2.AppPermsProperties{
+				Read:  &readRoles,
+				Write: &writeRoles,
+			},
+		}
+		b, _ = json.Marshal(&appPerms)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,184 @@
+package apps
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceExportKey = "scp_app_export"
+
+	schemaKeyOutputPath = "output_path"
+	schemaKeyDefault    = "default"
+	schemaKeyLocal      = "local"
+	schemaKeyUsers      = "users"
+	schemaKeyConfsOnly  = "confs_only"
+	schemaKeyChecksum   = "checksum"
+	schemaKeyFiles      = "files"
+)
+
+func appExportDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyName: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name (app ID) of the app to export.",
+		},
+		schemaKeyOutputPath: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Local path the exported archive of the app is written to.",
+		},
+		schemaKeyDefault: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Export the default configs of the app under etc/apps/<app_id>/default/*. Defaults to false.",
+		},
+		schemaKeyLocal: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Export the local configs of the app under etc/apps/<app_id>/local/*. Defaults to true.",
+		},
+		schemaKeyUsers: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "Export the configs and data under etc/users/*/<app_id>/* of the users the requester has access to. " +
+				"Defaults to false.",
+		},
+		schemaKeyConfsOnly: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Export only the configs selected by default, local and users without any app data. Defaults to false.",
+		},
+		schemaKeyChecksum: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The SHA-256 checksum of the exported archive.",
+		},
+		schemaKeyFiles: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The files contained in the exported archive.",
+		},
+	}
+}
+
+func DataSourceAppExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "App Export Data Source. Use this data source to download the configuration of an app to a local archive, " +
+			"for example to snapshot knowledge objects before upgrading an app. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageApps for more latest, detailed information on the ACS Apps API.",
+
+		ReadContext: dataSourceAppExportRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: appExportDataSourceSchema(),
+	}
+}
+
+func dataSourceAppExportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+	if err := client.CheckStackTypeSupported("app export", acsProvider.StackType, client.StackTypeVictoria); err != nil {
+		return diag.FromErr(err)
+	}
+
+	appName := d.Get(schemaKeyName).(string)
+	outputPath := d.Get(schemaKeyOutputPath).(string)
+
+	exportParams := parseExportParams(d)
+	archive, err := WaitAppExport(ctx, acsClient, stack, appName, exportParams)
+	if err != nil {
+		return diag.Errorf("Error downloading export of app (%s): %s", appName, err)
+	}
+
+	files, err := ListExportFiles(archive)
+	if err != nil {
+		return diag.Errorf("Error reading export of app (%s): %s", appName, err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return diag.Errorf("Error creating directory for export of app (%s): %s", appName, err)
+	}
+	if err = os.WriteFile(outputPath, archive, 0o644); err != nil {
+		return diag.Errorf("Error writing export of app (%s) to %s: %s", appName, outputPath, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Downloaded export of app (%s) to %s\n", appName, outputPath))
+
+	if err := d.Set(schemaKeyChecksum, PackageHash(archive)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyFiles, files); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(appName)
+
+	return nil
+}
+
+func parseExportParams(d *schema.ResourceData) v2.DownloadAppExportVictoriaParams {
+	defaultDirectory := v2.DefaultDirectory(d.Get(schemaKeyDefault).(bool))
+	localDirectory := v2.LocalDirectory(d.Get(schemaKeyLocal).(bool))
+	usersDirectory := v2.UsersDirectory(d.Get(schemaKeyUsers).(bool))
+	confsOnly := v2.ConfsOnly(d.Get(schemaKeyConfsOnly).(bool))
+
+	return v2.DownloadAppExportVictoriaParams{
+		Default:   &defaultDirectory,
+		Local:     &localDirectory,
+		Users:     &usersDirectory,
+		ConfsOnly: &confsOnly,
+	}
+}
+
+// ListExportFiles returns the names of the files in an exported app archive, the archive may be a gzip compressed or plain tar file
+func ListExportFiles(archive []byte) ([]string, error) {
+	var reader io.Reader = bytes.NewReader(archive)
+	if gzipReader, err := gzip.NewReader(bytes.NewReader(archive)); err == nil {
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	files := make([]string, 0)
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read app export archive: %w", err)
+		}
+		if header.Typeflag == tar.TypeReg {
+			files = append(files, header.Name)
+		}
+	}
+	return files, nil
+}
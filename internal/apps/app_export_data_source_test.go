@@ -0,0 +1,104 @@
+package apps_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+)
+
+const appExportDataSourceTemplate = `
+data "scp_app_export" %[1]q {
+	name        = %[1]q
+	output_path = %[2]q
+	default     = true
+}
+`
+
+var (
+	mockExportFiles   = []string{"search/local/savedsearches.conf", "search/local/data/ui/views/mock_dashboard.xml"}
+	mockExportArchive = genExportArchive(mockExportFiles)
+
+	mockDefaultDirectory = v2.DefaultDirectory(false)
+	mockLocalDirectory   = v2.LocalDirectory(true)
+	mockUsersDirectory   = v2.UsersDirectory(false)
+	mockConfsOnly        = v2.ConfsOnly(false)
+	mockExportParams     = v2.DownloadAppExportVictoriaParams{
+		Default:   &mockDefaultDirectory,
+		Local:     &mockLocalDirectory,
+		Users:     &mockUsersDirectory,
+		ConfsOnly: &mockConfsOnly,
+	}
+)
+
+func TestAcc_SplunkCloudAppExport_DataSource_basic(t *testing.T) {
+	appName := "search"
+	outputPath := filepath.Join(t.TempDir(), "search.tar.gz")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(appExportDataSourceTemplate, appName, outputPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_app_export.%s", appName), "checksum"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_app_export.%s", appName), "files.#"),
+					func(_ *terraform.State) error {
+						_, err := os.Stat(outputPath)
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func Test_ListExportFiles(t *testing.T) {
+	t.Run("with gzip compressed archive", func(t *testing.T) {
+		files, err := apps.ListExportFiles(mockExportArchive)
+		assert.NoError(t, err)
+		assert.Equal(t, mockExportFiles, files)
+	})
+
+	t.Run("with invalid archive", func(t *testing.T) {
+		files, err := apps.ListExportFiles([]byte("not an archive"))
+		assert.Error(t, err)
+		assert.Nil(t, files)
+	})
+}
+
+// genExportArchive returns a gzip compressed tar archive containing the given files
+func genExportArchive(files []string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, file := range files {
+		content := []byte("mock-content")
+		_ = tarWriter.WriteHeader(&tar.Header{Name: file, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		_, _ = tarWriter.Write(content)
+	}
+	_ = tarWriter.Close()
+	_ = gzipWriter.Close()
+	return buf.Bytes()
+}
+
+func genExportResp(code int) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.WriteHeader(code)
+	if code == http.StatusOK {
+		_, _ = recorder.Write(mockExportArchive)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,471 @@
+package apps
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	ResourceKey = "scp_apps"
+
+	schemaKeyName               = "name"
+	schemaKeySplunkbaseID       = "splunkbase_id"
+	schemaKeyVersion            = "version"
+	schemaKeyPackagePath        = "package_path"
+	schemaKeyLabel              = "label"
+	schemaKeyStatus             = "status"
+	schemaKeyACSLegalAck        = "acs_legal_ack"
+	schemaKeyACSLicensingAck    = "acs_licensing_ack"
+	schemaKeySplunkbaseUsername = "splunkbase_username"
+	schemaKeySplunkbasePassword = "splunkbase_password"
+	schemaKeyAppInspectToken    = "appinspect_token"
+	schemaKeyAppInspectUsername = "appinspect_username"
+	schemaKeyAppInspectPassword = "appinspect_password"
+	schemaKeyAppInspectLoginURL = "appinspect_login_url"
+	schemaKeyAppInspectURL      = "appinspect_url"
+	schemaKeyPackageHash        = "package_hash"
+)
+
+func appResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyName: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Description: "The name (app ID) of the app. Computed from the install response if not set. " +
+				"Can not be updated after creation, if changed in config file terraform will propose a replacement.",
+		},
+		schemaKeySplunkbaseID: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{schemaKeySplunkbaseID, schemaKeyPackagePath},
+			Description:  "The Splunkbase ID of the app to install. Can not be set with package_path.",
+		},
+		schemaKeyVersion: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "The version of the Splunkbase app to install. If not set, the latest version is installed. " +
+				"Changing the version of a Splunkbase app upgrades the app in place.",
+		},
+		schemaKeyPackagePath: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{schemaKeySplunkbaseID, schemaKeyPackagePath},
+			Description:  "Path to a local private app package (.tar.gz, .tgz or .spl) to install. Can not be set with splunkbase_id.",
+		},
+		schemaKeyLabel: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The label of the app.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The installation status of the app.",
+		},
+		schemaKeyACSLegalAck: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Required for private apps, you must set this attribute to a value of \"Y\". " +
+				"This header acknowledges that you are responsible for the private app you are installing.",
+		},
+		schemaKeyACSLicensingAck: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Required for Splunkbase apps, you must set this attribute to the license URL of the app listed on Splunkbase. " +
+				"This header acknowledges that you accept the license of the app.",
+		},
+		schemaKeySplunkbaseUsername: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{schemaKeySplunkbasePassword},
+			Description:  "Splunkbase username used to install and update Splunkbase apps.",
+		},
+		schemaKeySplunkbasePassword: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{schemaKeySplunkbaseUsername},
+			Description:  "Splunkbase password used to install and update Splunkbase apps.",
+		},
+		schemaKeyAppInspectToken: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{schemaKeyAppInspectUsername},
+			Description: "An AppInspect token of a private app package that has already passed AppInspect validation. " +
+				"Can not be set with appinspect_username, use appinspect_username and appinspect_password to have the provider validate the package instead.",
+		},
+		schemaKeyAppInspectUsername: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{schemaKeyAppInspectPassword},
+			Description:  "Splunk.com username used to authenticate with AppInspect and validate private app packages before they are installed.",
+		},
+		schemaKeyAppInspectPassword: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{schemaKeyAppInspectUsername},
+			Description:  "Splunk.com password used to authenticate with AppInspect and validate private app packages before they are installed.",
+		},
+		schemaKeyAppInspectLoginURL: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     AppInspectLoginURL,
+			Description: fmt.Sprintf("The login endpoint used to obtain an AppInspect token. Defaults to %s.", AppInspectLoginURL),
+		},
+		schemaKeyAppInspectURL: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     AppInspectURL,
+			Description: fmt.Sprintf("The AppInspect API base URL private app packages are submitted to for validation. Defaults to %s.", AppInspectURL),
+		},
+		schemaKeyPackageHash: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The SHA-256 hash of the private app package. A change to the package file causes the app to be installed again.",
+		},
+	}
+}
+
+func ResourceApp() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "App Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageApps " +
+			"for more latest, detailed information on attribute requirements and the ACS Apps API.",
+
+		CreateContext: resourceAppCreate,
+		ReadContext:   resourceAppRead,
+		UpdateContext: resourceAppUpdate,
+		DeleteContext: resourceAppDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceAppCustomizeDiff,
+
+		Schema: appResourceSchema(),
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+	if err := client.CheckStackTypeSupported("app management", acsProvider.StackType, client.StackTypeVictoria, client.StackTypeClassic); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Retrieve data for each field and create request params and body
+	installParams, contentType, body, err := parseInstallRequest(ctx, d, acsProvider.StackType)
+	if err != nil {
+		return diag.Errorf("Error preparing request for app to be installed: %s", err)
+	}
+
+	app, err := WaitAppInstall(ctx, acsClient, stack, acsProvider.StackType, installParams, contentType, body)
+	if err != nil {
+		if errors.IsConflictError(err) {
+			return diag.Errorf("App already exists, use terraform import to bring current app under terraform management")
+		}
+		return diag.Errorf("Error submitting request for app to be installed: %s", err)
+	}
+
+	appName := app.Name
+
+	// Set ID of app resource to indicate app has been installed
+	d.SetId(appName)
+
+	// The ID is set before the name is checked so that a mismatching app is kept in state, and uninstalled when the
+	// tainted resource is replaced, rather than left on the stack
+	if name, ok := d.GetOk(schemaKeyName); ok && name.(string) != appName {
+		return diag.Errorf("Installed app name (%s) does not match configured name (%s)", appName, name.(string))
+	}
+
+	if _, ok := d.GetOk(schemaKeyPackagePath); ok {
+		if err = d.Set(schemaKeyPackageHash, PackageHash(body)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Poll app until it reports installed status
+	if err = WaitAppPollInstalled(ctx, acsClient, stack, acsProvider.StackType, appName, d.Get(schemaKeyVersion).(string)); err != nil {
+		return diag.Errorf("Error waiting for app (%s) to be installed: %s", appName, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Installed app resource: %s\n", appName))
+
+	// Call readApp to set attributes of app
+	return resourceAppRead(ctx, d, m)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+	if err := client.CheckStackTypeSupported("app management", acsProvider.StackType, client.StackTypeVictoria, client.StackTypeClassic); err != nil {
+		return diag.FromErr(err)
+	}
+
+	appName := d.Id()
+
+	app, err := WaitAppRead(ctx, acsClient, stack, acsProvider.StackType, appName)
+	if err != nil {
+		// if app not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing app from state. Not Found error while reading app (%s): %s.", appName, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading app (%s): %s", appName, err)
+	}
+
+	if err := d.Set(schemaKeyName, app.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if app.SplunkbaseID != nil && *app.SplunkbaseID != "" {
+		if err := d.Set(schemaKeySplunkbaseID, app.SplunkbaseID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set(schemaKeyVersion, app.Version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyLabel, app.Label); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStatus, app.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+	if err := client.CheckStackTypeSupported("app management", acsProvider.StackType, client.StackTypeVictoria, client.StackTypeClassic); err != nil {
+		return diag.FromErr(err)
+	}
+
+	appName := d.Id()
+
+	if d.HasChange(schemaKeyVersion) {
+		if _, ok := d.GetOk(schemaKeySplunkbaseID); !ok {
+			return diag.Errorf("version of private app (%s) can not be updated, install a new app package instead", appName)
+		}
+
+		patchParams, body, err := parsePatchRequest(ctx, d)
+		if err != nil {
+			return diag.Errorf("Error preparing request for app (%s) to be updated: %s", appName, err)
+		}
+
+		if err = WaitAppUpdate(ctx, acsClient, stack, acsProvider.StackType, appName, patchParams, body); err != nil {
+			return diag.Errorf("Error submitting request for app (%s) to be updated: %s", appName, err)
+		}
+
+		// Poll until app is installed with the new version
+		if err = WaitAppPollInstalled(ctx, acsClient, stack, acsProvider.StackType, appName, d.Get(schemaKeyVersion).(string)); err != nil {
+			return diag.Errorf("Error waiting for app (%s) to be updated: %s", appName, err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("updated app resource: %s\n", appName))
+	return resourceAppRead(ctx, d, m)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+	if err := client.CheckStackTypeSupported("app management", acsProvider.StackType, client.StackTypeVictoria, client.StackTypeClassic); err != nil {
+		return diag.FromErr(err)
+	}
+
+	appName := d.Id()
+
+	if err := WaitAppDelete(ctx, acsClient, stack, acsProvider.StackType, appName); err != nil {
+		return diag.Errorf("Error uninstalling app (%s): %s", appName, err)
+	}
+
+	// Poll app until GET returns 404 Not found - app has been uninstalled
+	if err := WaitAppPoll(ctx, acsClient, stack, acsProvider.StackType, appName, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted); err != nil {
+		return diag.Errorf("Error waiting for app (%s) to be uninstalled: %s", appName, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("uninstalled app resource: %s\n", appName))
+	return nil
+}
+
+// resourceAppCustomizeDiff tracks the hash of the private app package so that a changed package file causes the app to be installed again
+func resourceAppCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	packagePath, ok := d.GetOk(schemaKeyPackagePath)
+	if !ok || !d.NewValueKnown(schemaKeyPackagePath) {
+		return nil
+	}
+
+	body, err := os.ReadFile(packagePath.(string))
+	if err != nil {
+		return fmt.Errorf("failed to read app package (%s): %w", packagePath.(string), err)
+	}
+
+	oldHash := d.Get(schemaKeyPackageHash).(string)
+	newHash := PackageHash(body)
+	if oldHash == newHash {
+		return nil
+	}
+
+	if err = d.SetNew(schemaKeyPackageHash, newHash); err != nil {
+		return err
+	}
+
+	// imported apps have no hash in state yet, so only replace apps installed from a different package
+	if d.Id() != "" && oldHash != "" {
+		return d.ForceNew(schemaKeyPackageHash)
+	}
+	return nil
+}
+
+// PackageHash returns the hex encoded SHA-256 hash of an app package
+func PackageHash(body []byte) string {
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:])
+}
+
+// parseInstallRequest returns the params, content type and body of the install request for either a Splunkbase app or a private app package
+func parseInstallRequest(ctx context.Context, d *schema.ResourceData, stackType string) (v2.InstallAppVictoriaParams, string, []byte, error) {
+	installParams := v2.InstallAppVictoriaParams{}
+
+	if splunkbaseID, ok := d.GetOk(schemaKeySplunkbaseID); ok {
+		licensingAck, ok := d.GetOk(schemaKeyACSLicensingAck)
+		if !ok {
+			return installParams, "", nil, fmt.Errorf("%s is required to install splunkbase apps", schemaKeyACSLicensingAck)
+		}
+
+		session, err := getSplunkbaseSession(ctx, d)
+		if err != nil {
+			return installParams, "", nil, err
+		}
+
+		splunkbase := true
+		parsedLicensingAck := licensingAck.(string)
+		installParams.Splunkbase = &splunkbase
+		installParams.XSplunkbaseAuthorization = &session
+		installParams.ACSLicensingAck = &parsedLicensingAck
+
+		form := url.Values{}
+		form.Set("splunkbaseID", splunkbaseID.(string))
+		if version, ok := d.GetOk(schemaKeyVersion); ok {
+			form.Set("version", version.(string))
+		}
+		return installParams, formContentType, []byte(form.Encode()), nil
+	}
+
+	legalAck, ok := d.GetOk(schemaKeyACSLegalAck)
+	if !ok {
+		return installParams, "", nil, fmt.Errorf("%s is required to install private apps", schemaKeyACSLegalAck)
+	}
+
+	packagePath := d.Get(schemaKeyPackagePath).(string)
+	body, err := os.ReadFile(packagePath)
+	if err != nil {
+		return installParams, "", nil, fmt.Errorf("failed to read app package (%s): %w", packagePath, err)
+	}
+
+	token, err := getAppInspectToken(ctx, d, filepath.Base(packagePath), body, stackType)
+	if err != nil {
+		return installParams, "", nil, err
+	}
+
+	parsedLegalAck := legalAck.(string)
+	installParams.ACSLegalAck = &parsedLegalAck
+	installParams.XSplunkAuthorization = &token
+
+	return installParams, packageContentType, body, nil
+}
+
+// parsePatchRequest returns the params and body of the update request for a Splunkbase app
+func parsePatchRequest(ctx context.Context, d *schema.ResourceData) (v2.PatchAppVictoriaParams, []byte, error) {
+	patchParams := v2.PatchAppVictoriaParams{}
+
+	licensingAck, ok := d.GetOk(schemaKeyACSLicensingAck)
+	if !ok {
+		return patchParams, nil, fmt.Errorf("%s is required to update splunkbase apps", schemaKeyACSLicensingAck)
+	}
+
+	session, err := getSplunkbaseSession(ctx, d)
+	if err != nil {
+		return patchParams, nil, err
+	}
+
+	patchParams.XSplunkbaseAuthorization = session
+	patchParams.ACSLicensingAck = licensingAck.(string)
+
+	form := url.Values{}
+	if version, ok := d.GetOk(schemaKeyVersion); ok {
+		form.Set("version", version.(string))
+	}
+	return patchParams, []byte(form.Encode()), nil
+}
+
+func getSplunkbaseSession(ctx context.Context, d *schema.ResourceData) (string, error) {
+	username := d.Get(schemaKeySplunkbaseUsername).(string)
+	password := d.Get(schemaKeySplunkbasePassword).(string)
+	return GetSplunkbaseSession(ctx, http.DefaultClient, SplunkbaseURL, username, password)
+}
+
+// getAppInspectToken returns the configured AppInspect token, or authenticates with AppInspect and validates the
+// app package against the tags of the stack experience to obtain one
+func getAppInspectToken(ctx context.Context, d *schema.ResourceData, fileName string, body []byte, stackType string) (string, error) {
+	if token, ok := d.GetOk(schemaKeyAppInspectToken); ok {
+		return token.(string), nil
+	}
+
+	username := d.Get(schemaKeyAppInspectUsername).(string)
+	password := d.Get(schemaKeyAppInspectPassword).(string)
+	if username == "" || password == "" {
+		return "", fmt.Errorf("either %s or %s and %s are required to install private apps", schemaKeyAppInspectToken, schemaKeyAppInspectUsername, schemaKeyAppInspectPassword)
+	}
+
+	token, err := GetAppInspectToken(ctx, http.DefaultClient, d.Get(schemaKeyAppInspectLoginURL).(string), username, password)
+	if err != nil {
+		return "", err
+	}
+
+	server := d.Get(schemaKeyAppInspectURL).(string)
+	requestID, err := SubmitAppInspectPackage(ctx, http.DefaultClient, server, token, fileName, body, AppInspectTags(stackType))
+	if err != nil {
+		return "", err
+	}
+
+	if err = WaitAppInspectValidate(ctx, http.DefaultClient, server, token, requestID); err != nil {
+		return "", err
+	}
+	return token, nil
+}
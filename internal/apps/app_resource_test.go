@@ -0,0 +1,169 @@
+package apps_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// Splunk Add-on for Amazon Web Services
+	splunkbaseID        = "1876"
+	splunkbaseAppName   = "Splunk_TA_aws"
+	splunkbaseLicense   = "https://www.splunk.com/en_us/legal/splunk-general-terms.html"
+	splunkbaseVersion   = "7.0.0"
+	splunkbaseUpgradeTo = "7.1.0"
+)
+
+func resourcePrefix(resourceName string) string {
+	return fmt.Sprint("scp_apps.", resourceName)
+}
+
+// preCheckSplunkbase skips the test if no Splunkbase credentials are available to install apps
+func preCheckSplunkbase(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("SPLUNKBASE_USERNAME") == "" || os.Getenv("SPLUNKBASE_PASSWORD") == "" {
+		t.Skip("`SPLUNKBASE_USERNAME` and `SPLUNKBASE_PASSWORD` must be set for splunkbase app acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudApp_Splunkbase(t *testing.T) {
+	resourceName := resource.UniqueId()
+
+	appResourceTest := []resource.TestStep{
+		// Install splunkbase app
+		{
+			Config: testAccInstanceConfigSplunkbase(resourceName, splunkbaseVersion),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "name", splunkbaseAppName),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "version", splunkbaseVersion),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "status", apps.AppStatusInstalled),
+			),
+		},
+		// Upgrade splunkbase app in place
+		{
+			Config: testAccInstanceConfigSplunkbase(resourceName, splunkbaseUpgradeTo),
+			Check:  resource.TestCheckResourceAttr(resourcePrefix(resourceName), "version", splunkbaseUpgradeTo),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckSplunkbase(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAppDestroy,
+		Steps:             appResourceTest,
+	})
+}
+
+func testAccInstanceConfigSplunkbase(resourceName string, version string) string {
+	return fmt.Sprintf(`resource "scp_apps" %[1]q {
+		splunkbase_id       = %[2]q
+		version             = %[3]q
+		acs_licensing_ack   = %[4]q
+		splunkbase_username = %[5]q
+		splunkbase_password = %[6]q
+	}`, resourceName, splunkbaseID, version, splunkbaseLicense, os.Getenv("SPLUNKBASE_USERNAME"), os.Getenv("SPLUNKBASE_PASSWORD"))
+}
+
+func testAccCheckAppDestroy(s *terraform.State) error {
+	providerNew := acctest.Provider
+	diags := providerNew.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags != nil {
+		return fmt.Errorf("%+v", diags)
+	}
+	acsProvider := providerNew.Meta().(client.ACSProvider).Client
+	acsClient := *acsProvider
+	stack := providerNew.Meta().(client.ACSProvider).Stack
+	stackType := providerNew.Meta().(client.ACSProvider).StackType
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != apps.ResourceKey {
+			continue
+		}
+
+		var resp *http.Response
+		var err error
+		switch stackType {
+		case client.StackTypeVictoria:
+			resp, err = acsClient.DescribeAppVictoria(context.TODO(), stack, v2.AppName(rs.Primary.ID))
+		case client.StackTypeClassic:
+			resp, err = acsClient.DescribeApp(context.TODO(), stack, v2.AppName(rs.Primary.ID))
+		default:
+			err = client.CheckStackTypeSupported("app read", stackType, client.StackTypeVictoria, client.StackTypeClassic)
+		}
+		if err != nil {
+			return fmt.Errorf("Unexpected Error %s", err)
+		}
+		statusCode := resp.StatusCode
+		if statusCode == http.StatusOK {
+			return fmt.Errorf("app still exists")
+		} else if statusCode != http.StatusNotFound {
+			return fmt.Errorf("expected %d, got %d", http.StatusNotFound, statusCode)
+		}
+	}
+
+	return nil
+}
+
+func Test_ResourceAppCustomizeDiff(t *testing.T) {
+	packagePath := filepath.Join(t.TempDir(), "mock-app.tgz")
+	if err := os.WriteFile(packagePath, []byte("mock-app-package"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"package_path":  packagePath,
+		"acs_legal_ack": "Y",
+	})
+
+	genState := func(packageHash string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "mock-app",
+			Attributes: map[string]string{
+				"id":                   "mock-app",
+				"name":                 "mock-app",
+				"package_path":         packagePath,
+				"package_hash":         packageHash,
+				"acs_legal_ack":        "Y",
+				"appinspect_login_url": apps.AppInspectLoginURL,
+				"appinspect_url":       apps.AppInspectURL,
+			},
+		}
+	}
+
+	t.Run("with unchanged package hash", func(t *testing.T) {
+		diff, err := apps.ResourceApp().Diff(context.TODO(), genState(apps.PackageHash([]byte("mock-app-package"))), config, nil)
+		assert.NoError(t, err)
+		assert.False(t, diff != nil && diff.RequiresNew())
+	})
+
+	t.Run("with changed package hash", func(t *testing.T) {
+		diff, err := apps.ResourceApp().Diff(context.TODO(), genState(apps.PackageHash([]byte("mock-app-package-v2"))), config, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, diff) {
+			assert.True(t, diff.RequiresNew())
+			assert.True(t, diff.Attributes["package_hash"].RequiresNew)
+			assert.Equal(t, apps.PackageHash([]byte("mock-app-package")), diff.Attributes["package_hash"].New)
+		}
+	})
+
+	t.Run("with imported app without package hash", func(t *testing.T) {
+		diff, err := apps.ResourceApp().Diff(context.TODO(), genState(""), config, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, diff) {
+			assert.False(t, diff.RequiresNew())
+			assert.Equal(t, apps.PackageHash([]byte("mock-app-package")), diff.Attributes["package_hash"].New)
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package apps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	AppInspectLoginURL = "https://api.splunk.com/2.0/rest/login/splunk"
+	AppInspectURL      = "https://appinspect.splunk.com"
+
+	appInspectValidatePath = "/v1/app/validate"
+	appInspectStatusPath   = "/v1/app/validate/status/"
+
+	AppInspectStatusPreparing  = "PREPARING"
+	AppInspectStatusProcessing = "PROCESSING"
+	AppInspectStatusSuccess    = "SUCCESS"
+	AppInspectStatusFailure    = "FAILURE"
+)
+
+var (
+	TargetStatusAppInspectValidated  = []string{AppInspectStatusSuccess}
+	PendingStatusAppInspectValidated = []string{AppInspectStatusPreparing, AppInspectStatusProcessing, http.StatusText(http.StatusTooManyRequests)}
+)
+
+// appInspectLoginResult is the response of the Splunk API login endpoint used to authenticate with AppInspect
+type appInspectLoginResult struct {
+	Data struct {
+		Token string `json:"token"`
+	} `json:"data"`
+}
+
+// appInspectSubmitResult is the response of the AppInspect validate endpoint
+type appInspectSubmitResult struct {
+	RequestID string `json:"request_id"`
+}
+
+// appInspectStatusResult is the response of the AppInspect validation status endpoint
+type appInspectStatusResult struct {
+	RequestID string `json:"request_id"`
+	Status    string `json:"status"`
+	Info      struct {
+		Error   int `json:"error"`
+		Failure int `json:"failure"`
+	} `json:"info"`
+}
+
+// GetAppInspectToken logs in to the AppInspect login endpoint and returns the token required by the X-Splunk-Authorization header
+func GetAppInspectToken(ctx context.Context, httpClient *http.Client, loginURL string, username string, password string) (string, error) {
+	if username == "" || password == "" {
+		return "", errors.New("appinspect username and password are required to install private apps")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, loginURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(username, password)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to log in to appinspect (%s): %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	var loginResult appInspectLoginResult
+	if err = json.Unmarshal(bodyBytes, &loginResult); err != nil {
+		return "", fmt.Errorf("unmarshal error: %v", err)
+	}
+
+	if loginResult.Data.Token == "" {
+		return "", errors.New("appinspect login response did not contain a token")
+	}
+	return loginResult.Data.Token, nil
+}
+
+// SubmitAppInspectPackage submits the app package for validation against the given tags and returns the request ID of the validation
+func SubmitAppInspectPackage(ctx context.Context, httpClient *http.Client, server string, token string, fileName string, appPackage []byte, includedTags string) (string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("app_package", fileName)
+	if err != nil {
+		return "", err
+	}
+	if _, err = part.Write(appPackage); err != nil {
+		return "", err
+	}
+	if err = writer.WriteField("included_tags", includedTags); err != nil {
+		return "", err
+	}
+	if err = writer.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(server, "/")+appInspectValidatePath, body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to submit app package to appinspect (%s): %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	var submitResult appInspectSubmitResult
+	if err = json.Unmarshal(bodyBytes, &submitResult); err != nil {
+		return "", fmt.Errorf("unmarshal error: %v", err)
+	}
+
+	if submitResult.RequestID == "" {
+		return "", errors.New("appinspect submit response did not contain a request id")
+	}
+	return submitResult.RequestID, nil
+}
+
+// AppInspectStatusValidate returns StateRefreshFunc that makes GET request for the validation status of an app package
+// and returns an error if the package did not pass validation
+func AppInspectStatusValidate(ctx context.Context, httpClient *http.Client, server string, token string, requestID string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(server, "/")+appInspectStatusPath+requestID, nil)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; ok {
+			return nil, http.StatusText(resp.StatusCode), nil
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: TargetStatusAppInspectValidated,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var statusResult appInspectStatusResult
+		if err = json.Unmarshal(bodyBytes, &statusResult); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+
+		if statusResult.Status == AppInspectStatusSuccess && (statusResult.Info.Error > 0 || statusResult.Info.Failure > 0) {
+			return nil, AppInspectStatusFailure, &resource.UnexpectedStateError{
+				State:         AppInspectStatusFailure,
+				ExpectedState: TargetStatusAppInspectValidated,
+				LastError: fmt.Errorf("app package did not pass appinspect validation (request id %s) with %d failures and %d errors",
+					requestID, statusResult.Info.Failure, statusResult.Info.Error),
+			}
+		}
+		return &statusResult, statusResult.Status, nil
+	}
+}
+
+// WaitAppInspectValidate Handles retry logic for polling the AppInspect validation of an app package until it has finished
+func WaitAppInspectValidate(ctx context.Context, httpClient *http.Client, server string, token string, requestID string) error {
+	waitValidated := wait.GenerateReadStateChangeConf(PendingStatusAppInspectValidated, TargetStatusAppInspectValidated, AppInspectStatusValidate(ctx, httpClient, server, token, requestID))
+
+	_, err := waitValidated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for appinspect validation (%s): %s", requestID, err))
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("App package passed appinspect validation with request ID %s\n", requestID))
+	return nil
+}
+
+// AppInspectTags returns the AppInspect tags a private app package is validated against for the experience of the stack
+func AppInspectTags(stackType string) string {
+	if stackType == client.StackTypeClassic {
+		return "private_classic"
+	}
+	return "private_victoria"
+}
@@ -0,0 +1,139 @@
+package apps_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mockAppInspectToken     = "mock-appinspect-token"
+	mockAppInspectRequestID = "mock-request-id"
+	mockAppInspectLoginPath = "/2.0/rest/login/splunk"
+)
+
+var mockAppPackage = []byte("mock-app-package")
+
+// newAppInspectStub returns a local AppInspect server that reports the given validation statuses in order
+func newAppInspectStub(failures int, statuses ...string) *httptest.Server {
+	polls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == mockAppInspectLoginPath {
+			if username, password, ok := r.BasicAuth(); !ok || username != "mock-user" || password != "mock-password" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"status": "success", "data": {"token": "%s"}}`, mockAppInspectToken)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+mockAppInspectToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/app/validate":
+			file, _, err := r.FormFile("app_package")
+			if err != nil || r.FormValue("included_tags") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			defer file.Close()
+			_, _ = fmt.Fprintf(w, `{"request_id": "%s"}`, mockAppInspectRequestID)
+		case "/v1/app/validate/status/" + mockAppInspectRequestID:
+			status := statuses[len(statuses)-1]
+			if polls < len(statuses) {
+				status = statuses[polls]
+			}
+			polls++
+			_, _ = fmt.Fprintf(w, `{"request_id": "%s", "status": "%s", "info": {"error": 0, "failure": %d}}`, mockAppInspectRequestID, status, failures)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func Test_GetAppInspectToken(t *testing.T) {
+	server := newAppInspectStub(0, apps.AppInspectStatusSuccess)
+	defer server.Close()
+
+	t.Run("with missing credentials", func(t *testing.T) {
+		token, err := apps.GetAppInspectToken(context.TODO(), server.Client(), server.URL+mockAppInspectLoginPath, "", "")
+		assert.Error(t, err)
+		assert.Empty(t, token)
+	})
+
+	t.Run("with invalid credentials", func(t *testing.T) {
+		token, err := apps.GetAppInspectToken(context.TODO(), server.Client(), server.URL+mockAppInspectLoginPath, "mock-user", "wrong-password")
+		assert.Error(t, err)
+		assert.Empty(t, token)
+	})
+
+	t.Run("with valid credentials", func(t *testing.T) {
+		token, err := apps.GetAppInspectToken(context.TODO(), server.Client(), server.URL+mockAppInspectLoginPath, "mock-user", "mock-password")
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppInspectToken, token)
+	})
+}
+
+func Test_SubmitAppInspectPackage(t *testing.T) {
+	server := newAppInspectStub(0, apps.AppInspectStatusSuccess)
+	defer server.Close()
+
+	t.Run("with invalid token", func(t *testing.T) {
+		requestID, err := apps.SubmitAppInspectPackage(context.TODO(), server.Client(), server.URL, "wrong-token", "mock_app.tgz", mockAppPackage, "private_victoria")
+		assert.Error(t, err)
+		assert.Empty(t, requestID)
+	})
+
+	t.Run("with valid token", func(t *testing.T) {
+		requestID, err := apps.SubmitAppInspectPackage(context.TODO(), server.Client(), server.URL, mockAppInspectToken, "mock_app.tgz", mockAppPackage, "private_victoria")
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppInspectRequestID, requestID)
+	})
+}
+
+func Test_WaitAppInspectValidate(t *testing.T) {
+	t.Run("with validation processing then successful", func(t *testing.T) {
+		server := newAppInspectStub(0, apps.AppInspectStatusProcessing, apps.AppInspectStatusSuccess)
+		defer server.Close()
+
+		err := apps.WaitAppInspectValidate(context.TODO(), server.Client(), server.URL, mockAppInspectToken, mockAppInspectRequestID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with validation failures", func(t *testing.T) {
+		server := newAppInspectStub(2, apps.AppInspectStatusSuccess)
+		defer server.Close()
+
+		err := apps.WaitAppInspectValidate(context.TODO(), server.Client(), server.URL, mockAppInspectToken, mockAppInspectRequestID)
+		assert.ErrorContains(t, err, "did not pass appinspect validation")
+	})
+
+	t.Run("with validation error status", func(t *testing.T) {
+		server := newAppInspectStub(0, apps.AppInspectStatusFailure)
+		defer server.Close()
+
+		err := apps.WaitAppInspectValidate(context.TODO(), server.Client(), server.URL, mockAppInspectToken, mockAppInspectRequestID)
+		assert.Error(t, err)
+	})
+
+	t.Run("with unknown request id", func(t *testing.T) {
+		server := newAppInspectStub(0, apps.AppInspectStatusSuccess)
+		defer server.Close()
+
+		err := apps.WaitAppInspectValidate(context.TODO(), server.Client(), server.URL, mockAppInspectToken, "unknown-request-id")
+		assert.Error(t, err)
+	})
+}
+
+func Test_PackageHash(t *testing.T) {
+	assert.Equal(t, apps.PackageHash(mockAppPackage), apps.PackageHash([]byte("mock-app-package")))
+	assert.NotEqual(t, apps.PackageHash(mockAppPackage), apps.PackageHash([]byte("mock-app-package-v2")))
+}
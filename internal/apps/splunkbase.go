@@ -0,0 +1,61 @@
+package apps
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	SplunkbaseURL       = "https://splunkbase.splunk.com"
+	splunkbaseLoginPath = "/api/account:login/"
+)
+
+// splunkbaseLoginResult is the atom feed returned by the Splunkbase login endpoint, the session ID is stored in the id element
+type splunkbaseLoginResult struct {
+	XMLName xml.Name `xml:"feed"`
+	ID      string   `xml:"id"`
+}
+
+// GetSplunkbaseSession logs in to Splunkbase and returns the session ID required by the X-Splunkbase-Authorization header
+func GetSplunkbaseSession(ctx context.Context, httpClient *http.Client, server string, username string, password string) (string, error) {
+	if username == "" || password == "" {
+		return "", errors.New("splunkbase username and password are required to install or update splunkbase apps")
+	}
+
+	form := url.Values{}
+	form.Set("username", username)
+	form.Set("password", password)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(server, "/")+splunkbaseLoginPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to log in to splunkbase (%s): %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	var loginResult splunkbaseLoginResult
+	if err = xml.Unmarshal(bodyBytes, &loginResult); err != nil {
+		return "", fmt.Errorf("unmarshal error: %v", err)
+	}
+
+	if loginResult.ID == "" {
+		return "", errors.New("splunkbase login response did not contain a session id")
+	}
+	return loginResult.ID, nil
+}
@@ -0,0 +1,47 @@
+package apps_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+)
+
+const mockLoginResponse = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Authentication Token</title>
+  <id>mock-session</id>
+</feed>`
+
+func Test_GetSplunkbaseSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.URL.Path != "/api/account:login/" || r.Form.Get("username") != "mock-user" || r.Form.Get("password") != "mock-password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(mockLoginResponse))
+	}))
+	defer server.Close()
+
+	t.Run("with missing credentials", func(t *testing.T) {
+		session, err := apps.GetSplunkbaseSession(context.TODO(), server.Client(), server.URL, "", "")
+		assert.Error(t, err)
+		assert.Empty(t, session)
+	})
+
+	t.Run("with invalid credentials", func(t *testing.T) {
+		session, err := apps.GetSplunkbaseSession(context.TODO(), server.Client(), server.URL, "mock-user", "wrong-password")
+		assert.Error(t, err)
+		assert.Empty(t, session)
+	})
+
+	t.Run("with valid credentials", func(t *testing.T) {
+		session, err := apps.GetSplunkbaseSession(context.TODO(), server.Client(), server.URL, "mock-user", "mock-password")
+		assert.NoError(t, err)
+		assert.Equal(t, mockSession, session)
+	})
+}
@@ -0,0 +1,246 @@
+package apps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// AppStatusInstall returns StateRefreshFunc that makes POST request to install an app and returns the app in the response if accepted
+func AppStatusInstall(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, installParams v2.InstallAppVictoriaParams, contentType string, body []byte) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := installApp(ctx, acsClient, stack, stackType, installParams, contentType, body)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return processAppResponse(resp)
+	}
+}
+
+// AppStatusUpdate returns StateRefreshFunc that makes PATCH request to update a splunkbase app and returns the app in the response if accepted
+func AppStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string, patchParams v2.PatchAppVictoriaParams, body []byte) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := patchApp(ctx, acsClient, stack, stackType, appName, patchParams, body)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return processAppResponse(resp)
+	}
+}
+
+// AppStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns app response
+func AppStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := describeApp(ctx, acsClient, stack, stackType, appName)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var app v2.App
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &app); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &app, status, nil
+	}
+}
+
+// AppStatusPollInstalled returns StateRefreshFunc that makes GET request and checks if the app has finished installing,
+// optionally at the given version
+func AppStatusPollInstalled(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string, version string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := describeApp(ctx, acsClient, stack, stackType, appName)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		// the app may not be visible right after the install request has been accepted
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; ok || resp.StatusCode == http.StatusNotFound {
+			return nil, http.StatusText(resp.StatusCode), nil
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: TargetStatusAppInstalled,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var app v2.App
+		if err = json.Unmarshal(bodyBytes, &app); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+
+		if slices.Contains(FailedStatusAppInstalled, app.Status) {
+			return &app, app.Status, &resource.UnexpectedStateError{
+				State:         app.Status,
+				ExpectedState: TargetStatusAppInstalled,
+				LastError:     fmt.Errorf("app (%s) failed to install", appName),
+			}
+		}
+
+		if app.Status == AppStatusInstalled && (version == "" || (app.Version != nil && *app.Version == version)) {
+			return &app, AppStatusInstalled, nil
+		}
+		return &app, AppStatusPending, nil
+	}
+}
+
+// AppStatusPoll returns StateRefreshFunc that makes GET request and checks if response is desired target (404 for delete)
+func AppStatusPoll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string, targetStatus []string, pendingStatus []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := describeApp(ctx, acsClient, stack, stackType, appName)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, targetStatus, pendingStatus)
+	}
+}
+
+// AppStatusDelete returns StateRefreshFunc that makes DELETE request and checks if request was accepted
+func AppStatusDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := uninstallApp(ctx, acsClient, stack, stackType, appName)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, TargetStatusAppChange, wait.PendingStatusCRUD)
+	}
+}
+
+// AppStatusExport returns StateRefreshFunc that makes GET request to download the export of an app and returns the exported archive
+func AppStatusExport(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, exportParams v2.DownloadAppExportVictoriaParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DownloadAppExportVictoria(ctx, stack, v2.AppName(appName), &exportParams)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		return bodyBytes, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// processAppResponse checks that an install or update request was accepted and returns the app from the response body
+func processAppResponse(resp *http.Response) (interface{}, string, error) {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+
+	_, statusText, err := status.ProcessResponse(resp, TargetStatusAppChange, wait.PendingStatusCRUD)
+	if err != nil {
+		return nil, statusText, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, statusText, nil
+	}
+
+	var app v2.App
+	if err = json.Unmarshal(bodyBytes, &app); err != nil {
+		return nil, "", &resource.UnexpectedStateError{LastError: err}
+	}
+	return &app, statusText, nil
+}
+
+// installApp makes the install request against the app endpoints of the stack experience
+func installApp(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, installParams v2.InstallAppVictoriaParams, contentType string, body []byte) (*http.Response, error) {
+	switch stackType {
+	case client.StackTypeVictoria:
+		return acsClient.InstallAppVictoriaWithBody(ctx, stack, &installParams, contentType, bytes.NewReader(body))
+	case client.StackTypeClassic:
+		classicParams := v2.InstallAppParams{
+			Splunkbase:               installParams.Splunkbase,
+			XSplunkbaseAuthorization: installParams.XSplunkbaseAuthorization,
+			XSplunkAuthorization:     installParams.XSplunkAuthorization,
+			ACSLicensingAck:          installParams.ACSLicensingAck,
+		}
+		if installParams.ACSLegalAck != nil {
+			classicParams.ACSLegalAck = *installParams.ACSLegalAck
+		}
+		return acsClient.InstallAppWithBody(ctx, stack, &classicParams, contentType, bytes.NewReader(body))
+	default:
+		return nil, client.CheckStackTypeSupported("app install", stackType, client.StackTypeVictoria, client.StackTypeClassic)
+	}
+}
+
+// patchApp makes the update request against the app endpoints of the stack experience
+func patchApp(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string, patchParams v2.PatchAppVictoriaParams, body []byte) (*http.Response, error) {
+	switch stackType {
+	case client.StackTypeVictoria:
+		return acsClient.PatchAppVictoriaWithBody(ctx, stack, v2.AppName(appName), &patchParams, formContentType, bytes.NewReader(body))
+	case client.StackTypeClassic:
+		classicParams := v2.PatchAppClassicParams(patchParams)
+		return acsClient.PatchAppClassicWithBody(ctx, stack, v2.AppName(appName), &classicParams, formContentType, bytes.NewReader(body))
+	default:
+		return nil, client.CheckStackTypeSupported("app update", stackType, client.StackTypeVictoria, client.StackTypeClassic)
+	}
+}
+
+// describeApp makes the describe request against the app endpoints of the stack experience
+func describeApp(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string) (*http.Response, error) {
+	switch stackType {
+	case client.StackTypeVictoria:
+		return acsClient.DescribeAppVictoria(ctx, stack, v2.AppName(appName))
+	case client.StackTypeClassic:
+		return acsClient.DescribeApp(ctx, stack, v2.AppName(appName))
+	default:
+		return nil, client.CheckStackTypeSupported("app read", stackType, client.StackTypeVictoria, client.StackTypeClassic)
+	}
+}
+
+// uninstallApp makes the uninstall request against the app endpoints of the stack experience
+func uninstallApp(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string) (*http.Response, error) {
+	switch stackType {
+	case client.StackTypeVictoria:
+		return acsClient.UninstallAppVictoria(ctx, stack, v2.AppName(appName), &v2.UninstallAppVictoriaParams{})
+	case client.StackTypeClassic:
+		return acsClient.UninstallApp(ctx, stack, v2.AppName(appName))
+	default:
+		return nil, client.CheckStackTypeSupported("app uninstall", stackType, client.StackTypeVictoria, client.StackTypeClassic)
+	}
+}
@@ -0,0 +1,119 @@
+package apps_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockAppName = "mock-app"
+	mockStack   = "mock-stack"
+
+	mockStackTypeVictoria = "victoria"
+	mockStackTypeClassic  = "classic"
+)
+
+var (
+	mockAppLabel       = "Mock App"
+	mockVersion        = "1.0.0"
+	mockUpdatedVersion = "2.0.0"
+	mockSplunkbaseID   = "1234"
+	mockStatusPending  = "processing"
+)
+
+func Test_AppStatusPollInstalled(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(nil, errors.New("some error")).Once()
+		_, _, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")()
+		assert.Error(t, err)
+	})
+
+	t.Run("with app not yet visible", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusNotFound, "", ""), nil).Once()
+		_, state, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), state)
+	})
+
+	t.Run("with app still installing", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, mockStatusPending, mockVersion), nil).Once()
+		_, state, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")()
+		assert.NoError(t, err)
+		assert.Equal(t, apps.AppStatusPending, state)
+	})
+
+	t.Run("with app installed", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockVersion), nil).Once()
+		app, state, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")()
+		assert.NoError(t, err)
+		assert.Equal(t, apps.AppStatusInstalled, state)
+		assert.Equal(t, mockAppName, app.(*v2.App).Name)
+	})
+
+	t.Run("with app failed to install", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusFailed, mockVersion), nil).Once()
+		_, state, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")()
+		assert.Error(t, err)
+		assert.Equal(t, apps.AppStatusFailed, state)
+	})
+
+	t.Run("with app installed at previous version", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockVersion), nil).Once()
+		_, state, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, mockUpdatedVersion)()
+		assert.NoError(t, err)
+		assert.Equal(t, apps.AppStatusPending, state)
+	})
+
+	t.Run("with app installed at requested version", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockUpdatedVersion), nil).Once()
+		_, state, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, mockUpdatedVersion)()
+		assert.NoError(t, err)
+		assert.Equal(t, apps.AppStatusInstalled, state)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusBadRequest, "", ""), nil).Once()
+		_, state, err := apps.AppStatusPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")()
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusText(http.StatusBadRequest), state)
+	})
+}
+
+// genAppResp returns a new response on every call since the body can only be read once
+func genAppResp(code int, status string, version string) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		app := v2.App{
+			Name:         mockAppName,
+			Label:        &mockAppLabel,
+			SplunkbaseID: &mockSplunkbaseID,
+			Status:       status,
+			Version:      &version,
+		}
+		b, _ = json.Marshal(&app)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,137 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	AppStatusInstalled = "installed"
+	AppStatusPending   = "pending"
+	AppStatusFailed    = "failed"
+
+	formContentType    = "application/x-www-form-urlencoded"
+	packageContentType = "application/octet-stream"
+)
+
+var (
+	// Install, update and uninstall requests are either processed synchronously (200) or accepted (202) depending on the app
+	TargetStatusAppChange    = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusAccepted)}
+	TargetStatusAppInstalled = []string{AppStatusInstalled}
+
+	PendingStatusAppInstalled = []string{AppStatusPending, http.StatusText(http.StatusNotFound), http.StatusText(http.StatusTooManyRequests)}
+	// An install that ACS reports with a failed status will not complete, so polling stops rather than waiting for the timeout
+	FailedStatusAppInstalled = []string{AppStatusFailed}
+)
+
+// WaitAppInstall Handles retry logic for POST requests for create lifecycle function and returns the app being installed
+func WaitAppInstall(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, installParams v2.InstallAppVictoriaParams, contentType string, body []byte) (*v2.App, error) {
+	waitAppInstallAccepted := wait.GenerateWriteStateChangeConf(AppStatusInstall(ctx, acsClient, stack, stackType, installParams, contentType, body))
+	waitAppInstallAccepted.Target = TargetStatusAppChange
+
+	output, err := waitAppInstallAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for app to be installed: %s", err))
+		return nil, err
+	}
+
+	app := output.(*v2.App)
+
+	// Log to user that request submitted and installation in progress
+	tflog.Info(ctx, fmt.Sprintf("Install request accepted for app (%s) with status: %s\n", app.Name, app.Status))
+
+	return app, nil
+}
+
+// WaitAppPollInstalled Handles retry logic for polling after POST and PATCH requests until the app is installed,
+// if version is not empty the installed app must also match the version
+func WaitAppPollInstalled(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string, version string) error {
+	waitAppInstalled := wait.GenerateReadStateChangeConf(PendingStatusAppInstalled, TargetStatusAppInstalled, AppStatusPollInstalled(ctx, acsClient, stack, stackType, appName, version))
+
+	_, err := waitAppInstalled.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for app (%s) to be installed: %s", appName, err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitAppRead Handles retry logic for GET requests for the read lifecycle function
+func WaitAppRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string) (*v2.App, error) {
+	waitAppRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, AppStatusRead(ctx, acsClient, stack, stackType, appName))
+
+	output, err := waitAppRead.WaitForStateContext(ctx)
+
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading app (%s): %s", appName, err))
+		return nil, err
+	}
+	app := output.(*v2.App)
+
+	return app, nil
+}
+
+// WaitAppUpdate Handles retry logic for PATCH requests for the update lifecycle function
+func WaitAppUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string, patchParams v2.PatchAppVictoriaParams, body []byte) error {
+	waitAppUpdateAccepted := wait.GenerateWriteStateChangeConf(AppStatusUpdate(ctx, acsClient, stack, stackType, appName, patchParams, body))
+	waitAppUpdateAccepted.Target = TargetStatusAppChange
+
+	output, err := waitAppUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for app (%s) to be updated: %s", appName, err))
+		return err
+	}
+
+	app := output.(*v2.App)
+
+	//Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update request accepted for app (%s) with status: %s\n", appName, app.Status))
+
+	return nil
+}
+
+// WaitAppDelete Handles retry logic for DELETE requests for the delete lifecycle function
+func WaitAppDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string) error {
+	waitAppDeleteAccepted := wait.GenerateWriteStateChangeConf(AppStatusDelete(ctx, acsClient, stack, stackType, appName))
+	waitAppDeleteAccepted.Target = TargetStatusAppChange
+
+	rawResp, err := waitAppDeleteAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error uninstalling app (%s): %s", appName, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	//Log to user that request submitted and deletion in progress
+	tflog.Info(ctx, fmt.Sprintf("Uninstall response status code for app (%s): %d\n", appName, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for app (%s): %s\n", appName, resp.Header.Get("X-REQUEST-ID")))
+	return nil
+}
+
+// WaitAppPoll Handles retry logic for polling after DELETE requests for the delete lifecycle function
+func WaitAppPoll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stackType string, appName string, targetStatus []string, pendingStatus []string) error {
+	waitAppState := wait.GenerateReadStateChangeConf(pendingStatus, targetStatus, AppStatusPoll(ctx, acsClient, stack, stackType, appName, targetStatus, pendingStatus))
+
+	_, err := waitAppState.WaitForStateContext(ctx)
+	return err
+}
+
+// WaitAppExport Handles retry logic for GET requests downloading the export of an app and returns the exported archive
+func WaitAppExport(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, exportParams v2.DownloadAppExportVictoriaParams) ([]byte, error) {
+	waitAppExport := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, AppStatusExport(ctx, acsClient, stack, appName, exportParams))
+
+	output, err := waitAppExport.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error downloading export of app (%s): %s", appName, err))
+		return nil, err
+	}
+
+	return output.([]byte), nil
+}
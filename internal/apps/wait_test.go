@@ -0,0 +1,303 @@
+package apps_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	unexpectedStatusCodes     = []int{400, 401, 403, 404, 409, 501}
+	unexpectedStatusCodesPoll = []int{400, 401, 403, 409, 501, 500, 503}
+
+	mockBody          = []byte("splunkbaseID=1234")
+	mockContentType   = "application/x-www-form-urlencoded"
+	mockLicensingAck  = "http://www.apache.org/licenses/LICENSE-2.0"
+	mockSession       = "mock-session"
+	mockInstallParams = v2.InstallAppVictoriaParams{
+		XSplunkbaseAuthorization: &mockSession,
+		ACSLicensingAck:          &mockLicensingAck,
+	}
+	mockPatchParams = v2.PatchAppVictoriaParams{
+		XSplunkbaseAuthorization: mockSession,
+		ACSLicensingAck:          mockLicensingAck,
+	}
+	mockClassicInstallParams = v2.InstallAppParams{
+		XSplunkbaseAuthorization: &mockSession,
+		ACSLicensingAck:          &mockLicensingAck,
+	}
+	mockClassicPatchParams = v2.PatchAppClassicParams{
+		XSplunkbaseAuthorization: mockSession,
+		ACSLicensingAck:          mockLicensingAck,
+	}
+)
+
+func Test_WaitAppInstall(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("InstallAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), &mockInstallParams, mockContentType, mock.Anything).Return(nil, errors.New("some error")).Once()
+		app, err := apps.WaitAppInstall(context.TODO(), client, mockStack, mockStackTypeVictoria, mockInstallParams, mockContentType, mockBody)
+		assert.Error(t, err)
+		assert.Nil(t, app)
+	})
+
+	for _, code := range []int{http.StatusOK, http.StatusAccepted} {
+		t.Run(fmt.Sprintf("with http response %v", code), func(t *testing.T) {
+			client.On("InstallAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), &mockInstallParams, mockContentType, mock.Anything).Return(genAppResp(code, mockStatusPending, mockVersion), nil).Once()
+			app, err := apps.WaitAppInstall(context.TODO(), client, mockStack, mockStackTypeVictoria, mockInstallParams, mockContentType, mockBody)
+			assert.NoError(t, err)
+			assert.Equal(t, mockAppName, app.Name)
+		})
+	}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("InstallAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), &mockInstallParams, mockContentType, mock.Anything).Return(genAppResp(http.StatusTooManyRequests, "", ""), nil).Once()
+		client.On("InstallAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), &mockInstallParams, mockContentType, mock.Anything).Return(genAppResp(http.StatusAccepted, mockStatusPending, mockVersion), nil).Once()
+		app, err := apps.WaitAppInstall(context.TODO(), client, mockStack, mockStackTypeVictoria, mockInstallParams, mockContentType, mockBody)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppName, app.Name)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("InstallAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), &mockInstallParams, mockContentType, mock.Anything).Return(genAppResp(code, "", ""), nil).Once()
+				app, err := apps.WaitAppInstall(context.TODO(), client, mockStack, mockStackTypeVictoria, mockInstallParams, mockContentType, mockBody)
+				assert.Error(t, err)
+				assert.Nil(t, app)
+			})
+		}
+	})
+}
+
+func Test_WaitAppPollInstalled(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with app installed", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockVersion), nil).Once()
+		err := apps.WaitAppPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("with app pending then installed", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusNotFound, "", ""), nil).Once()
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, mockStatusPending, mockUpdatedVersion), nil).Once()
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockUpdatedVersion), nil).Once()
+		err := apps.WaitAppPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, mockUpdatedVersion)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodesPoll {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(code, "", ""), nil).Once()
+				err := apps.WaitAppPollInstalled(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, "")
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitAppRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(nil, errors.New("some error")).Once()
+		app, err := apps.WaitAppRead(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName)
+		assert.Error(t, err)
+		assert.Nil(t, app)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockVersion), nil).Once()
+		app, err := apps.WaitAppRead(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppName, app.Name)
+		assert.Equal(t, mockVersion, *app.Version)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(code, "", ""), nil).Once()
+				app, err := apps.WaitAppRead(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName)
+				assert.Error(t, err)
+				assert.Nil(t, app)
+			})
+		}
+	})
+}
+
+func Test_WaitAppUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("PatchAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockPatchParams, mockContentType, mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := apps.WaitAppUpdate(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, mockPatchParams, mockBody)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("PatchAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockPatchParams, mockContentType, mock.Anything).Return(genAppResp(http.StatusAccepted, mockStatusPending, mockUpdatedVersion), nil).Once()
+		err := apps.WaitAppUpdate(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, mockPatchParams, mockBody)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("PatchAppVictoriaWithBody", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockPatchParams, mockContentType, mock.Anything).Return(genAppResp(code, "", ""), nil).Once()
+				err := apps.WaitAppUpdate(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, mockPatchParams, mockBody)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitAppDelete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("UninstallAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := apps.WaitAppDelete(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("UninstallAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), mock.Anything).Return(genAppResp(http.StatusOK, "", ""), nil).Once()
+		err := apps.WaitAppDelete(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("UninstallAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), mock.Anything).Return(genAppResp(code, "", ""), nil).Once()
+				err := apps.WaitAppDelete(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitAppPoll(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http response 404 verify delete", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusNotFound, "", ""), nil).Once()
+		err := apps.WaitAppPoll(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 200 verify delete", func(t *testing.T) {
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockVersion), nil).Once()
+		client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusNotFound, "", ""), nil).Once()
+		err := apps.WaitAppPoll(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodesPoll {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("DescribeAppVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(code, "", ""), nil).Once()
+				err := apps.WaitAppPoll(context.TODO(), client, mockStack, mockStackTypeVictoria, mockAppName, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitAppClassic(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("install routes to classic endpoint", func(t *testing.T) {
+		client.On("InstallAppWithBody", mock.Anything, v2.Stack(mockStack), &mockClassicInstallParams, mockContentType, mock.Anything).Return(genAppResp(http.StatusAccepted, mockStatusPending, mockVersion), nil).Once()
+		app, err := apps.WaitAppInstall(context.TODO(), client, mockStack, mockStackTypeClassic, mockInstallParams, mockContentType, mockBody)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppName, app.Name)
+	})
+
+	t.Run("read routes to classic endpoint", func(t *testing.T) {
+		client.On("DescribeApp", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, apps.AppStatusInstalled, mockVersion), nil).Once()
+		app, err := apps.WaitAppRead(context.TODO(), client, mockStack, mockStackTypeClassic, mockAppName)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppName, app.Name)
+	})
+
+	t.Run("update routes to classic endpoint", func(t *testing.T) {
+		client.On("PatchAppClassicWithBody", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockClassicPatchParams, mockContentType, mock.Anything).Return(genAppResp(http.StatusAccepted, mockStatusPending, mockUpdatedVersion), nil).Once()
+		err := apps.WaitAppUpdate(context.TODO(), client, mockStack, mockStackTypeClassic, mockAppName, mockPatchParams, mockBody)
+		assert.NoError(t, err)
+	})
+
+	t.Run("uninstall routes to classic endpoint", func(t *testing.T) {
+		client.On("UninstallApp", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusOK, "", ""), nil).Once()
+		err := apps.WaitAppDelete(context.TODO(), client, mockStack, mockStackTypeClassic, mockAppName)
+		assert.NoError(t, err)
+	})
+
+	t.Run("poll routes to classic endpoint", func(t *testing.T) {
+		client.On("DescribeApp", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName)).Return(genAppResp(http.StatusNotFound, "", ""), nil).Once()
+		err := apps.WaitAppPoll(context.TODO(), client, mockStack, mockStackTypeClassic, mockAppName, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+		assert.NoError(t, err)
+	})
+
+	client.AssertExpectations(t)
+}
+
+func Test_WaitAppUnknownStackType(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with unknown stack type", func(t *testing.T) {
+		app, err := apps.WaitAppRead(context.TODO(), client, mockStack, "", mockAppName)
+		assert.ErrorContains(t, err, "app read is not supported")
+		assert.Nil(t, app)
+	})
+}
+
+func Test_WaitAppExport(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DownloadAppExportVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockExportParams).Return(nil, errors.New("some error")).Once()
+		archive, err := apps.WaitAppExport(context.TODO(), client, mockStack, mockAppName, mockExportParams)
+		assert.Error(t, err)
+		assert.Nil(t, archive)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DownloadAppExportVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockExportParams).Return(genExportResp(http.StatusOK), nil).Once()
+		archive, err := apps.WaitAppExport(context.TODO(), client, mockStack, mockAppName, mockExportParams)
+		assert.NoError(t, err)
+		assert.Equal(t, mockExportArchive, archive)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DownloadAppExportVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockExportParams).Return(genExportResp(http.StatusTooManyRequests), nil).Once()
+		client.On("DownloadAppExportVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockExportParams).Return(genExportResp(http.StatusOK), nil).Once()
+		archive, err := apps.WaitAppExport(context.TODO(), client, mockStack, mockAppName, mockExportParams)
+		assert.NoError(t, err)
+		assert.Equal(t, mockExportArchive, archive)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("DownloadAppExportVictoria", mock.Anything, v2.Stack(mockStack), v2.AppName(mockAppName), &mockExportParams).Return(genExportResp(code), nil).Once()
+				archive, err := apps.WaitAppExport(context.TODO(), client, mockStack, mockAppName, mockExportParams)
+				assert.Error(t, err)
+				assert.Nil(t, archive)
+			})
+		}
+	})
+}
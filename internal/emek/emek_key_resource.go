@@ -0,0 +1,160 @@
+package emek
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	ResourceKey = "scp_emek_key"
+
+	schemaKeyKeyARN           = "key_arn"
+	schemaKeyAllowKeyRotation = "allow_key_rotation"
+	schemaKeyWaiverStatus     = "waiver_status"
+	schemaKeyWaiver           = "waiver"
+)
+
+func emekKeyResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyKeyARN: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^arn:[a-z-]+:kms:`), "must be the ARN of an AWS KMS key")),
+			Description:      "The ARN of the AWS KMS key to encrypt the data of the stack with. Changing the key rotates it and requires allow_key_rotation to be set.",
+		},
+		schemaKeyAllowKeyRotation: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Set to true to allow changing key_arn of a registered key, which rotates the encryption key of the stack. Defaults to false.",
+		},
+		schemaKeyWaiverStatus: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The status of the EMEK waiver of the stack, read from the `status` attribute of the waiver or, if absent, the `waiverStatus` attribute. " +
+				"Empty if the stack has no waiver or the waiver has neither attribute.",
+		},
+		schemaKeyWaiver: {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "All top level attributes of the EMEK waiver of the stack as returned by ACS, with objects and arrays JSON encoded and null values empty.",
+		},
+	}
+}
+
+func ResourceEmekKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "EMEK Key Resource. Use this resource to register the customer managed AWS KMS key used for Enterprise " +
+			"Managed Encryption Keys (EMEK) of a stack. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Admin/ConfigureEMEK " +
+			"for more latest, detailed information on attribute requirements and the ACS EMEK API.",
+
+		CreateContext: resourceEmekKeyCreate,
+		ReadContext:   resourceEmekKeyRead,
+		UpdateContext: resourceEmekKeyUpdate,
+		DeleteContext: resourceEmekKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceEmekKeyCustomizeDiff,
+
+		Schema: emekKeyResourceSchema(),
+	}
+}
+
+func resourceEmekKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	keyARN := d.Get(schemaKeyKeyARN).(string)
+
+	if err := WaitEmekKeyPut(ctx, acsClient, stack, keyARN); err != nil {
+		return diag.Errorf("Error submitting request for EMEK key (%s) to be registered: %s", keyARN, err)
+	}
+
+	// There is a single EMEK key per stack, the stack is used as ID of the resource
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Registered EMEK key resource: %s\n", keyARN))
+
+	return resourceEmekKeyRead(ctx, d, m)
+}
+
+func resourceEmekKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// ACS does not return the registered key, only the waiver is read
+	waiver, err := WaitEmekWaiverRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading EMEK waiver (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(schemaKeyWaiverStatus, WaiverStatus(waiver)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyWaiver, waiver); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceEmekKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChange(schemaKeyKeyARN) {
+		keyARN := d.Get(schemaKeyKeyARN).(string)
+
+		if err := WaitEmekKeyPut(ctx, acsClient, stack, keyARN); err != nil {
+			return diag.Errorf("Error submitting request for EMEK key (%s) to be rotated: %s", keyARN, err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Rotated EMEK key resource: %s\n", keyARN))
+	}
+
+	return resourceEmekKeyRead(ctx, d, m)
+}
+
+func resourceEmekKeyDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support deregistering the EMEK key, the key is only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("EMEK key of stack (%s) can not be deregistered through ACS, removing it from state only.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// resourceEmekKeyCustomizeDiff fails the plan if the registered key would or could be rotated without allow_key_rotation
+func resourceEmekKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange(schemaKeyKeyARN) {
+		return nil
+	}
+
+	if d.Get(schemaKeyAllowKeyRotation).(bool) {
+		return nil
+	}
+
+	oldKeyARN, newKeyARN := d.GetChange(schemaKeyKeyARN)
+	// an imported key has no known key ARN, registering key_arn rotates the key unless it is the registered key
+	if oldKeyARN.(string) == "" {
+		return fmt.Errorf("the registered EMEK key of the imported stack is unknown and registering %s may rotate it, "+
+			"set allow_key_rotation to true to allow registering the key", newKeyARN)
+	}
+	return fmt.Errorf("changing key_arn from %s to %s rotates the EMEK key of the stack, set allow_key_rotation to true to allow the rotation", oldKeyARN, newKeyARN)
+}
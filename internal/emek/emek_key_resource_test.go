@@ -0,0 +1,57 @@
+package emek_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+// preCheckEmek skips the test if no KMS key with the EMEK key policy is known to register
+func preCheckEmek(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("EMEK_KEY_ARN") == "" || os.Getenv("EMEK_LEGAL_ACK") == "" {
+		t.Skip("`EMEK_KEY_ARN` and `EMEK_LEGAL_ACK` must be set for EMEK acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudEmekKey(t *testing.T) {
+	keyARN := os.Getenv("EMEK_KEY_ARN")
+	rotatedKeyARN := "arn:aws:kms:us-east-1:123456789012:key/00000000-0000-0000-0000-000000000000"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckEmek(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read key policy and register key
+			{
+				Config: testAccInstanceConfigEmekKey(keyARN, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_emek_policy.test", "policy"),
+					resource.TestCheckResourceAttrSet("data.scp_emek_policy.test", "region"),
+					resource.TestCheckResourceAttr("scp_emek_key.test", "key_arn", keyARN),
+				),
+			},
+			// Rotating the key without opt in fails the plan
+			{
+				Config:      testAccInstanceConfigEmekKey(rotatedKeyARN, false),
+				ExpectError: regexp.MustCompile("allow_key_rotation"),
+			},
+		},
+	})
+}
+
+func testAccInstanceConfigEmekKey(keyARN string, allowKeyRotation bool) string {
+	return fmt.Sprintf(`
+	data "scp_emek_policy" "test" {
+		legal_ack = %[1]q
+	}
+
+	resource "scp_emek_key" "test" {
+		key_arn            = %[2]q
+		allow_key_rotation = %[3]t
+	}`, os.Getenv("EMEK_LEGAL_ACK"), keyARN, allowKeyRotation)
+}
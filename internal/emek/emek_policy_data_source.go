@@ -0,0 +1,96 @@
+package emek
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	PolicyDataSourceKey = "scp_emek_policy"
+
+	schemaKeyLegalAck = "legal_ack"
+	schemaKeyPolicy   = "policy"
+	schemaKeyRegion   = "region"
+	schemaKeyMessage  = "message"
+)
+
+func emekPolicyDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyLegalAck: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The acknowledgement of the EMEK legal terms, sent as the EMEK-Legal-Ack header. ACS only generates the key policy once the terms are acknowledged.",
+		},
+		schemaKeyPolicy: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The KMS key policy as a JSON string, to be applied to the key before it is registered.",
+		},
+		schemaKeyRegion: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The AWS region the KMS key must be created in.",
+		},
+		schemaKeyMessage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Instructions from ACS on applying the key policy.",
+		},
+	}
+}
+
+func DataSourceEmekPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "EMEK Policy Data Source. Use this data source to generate the KMS key policy and region of the customer " +
+			"managed key used for Enterprise Managed Encryption Keys (EMEK) of a stack. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Admin/ConfigureEMEK " +
+			"for more latest, detailed information on the ACS EMEK API.",
+
+		ReadContext: dataSourceEmekPolicyRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: emekPolicyDataSourceSchema(),
+	}
+}
+
+func dataSourceEmekPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	policy, err := WaitEmekPolicyRead(ctx, acsClient, stack, d.Get(schemaKeyLegalAck).(string))
+	if err != nil {
+		return diag.Errorf("Error reading EMEK key policy: %s", err)
+	}
+
+	policyJSON, err := utils.FlattenPolicy(policy.Policy)
+	if err != nil {
+		return diag.Errorf("Error encoding EMEK key policy: %s", err)
+	}
+
+	if err := d.Set(schemaKeyPolicy, policyJSON); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRegion, policy.Region); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyMessage, policy.Message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
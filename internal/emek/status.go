@@ -0,0 +1,137 @@
+package emek
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// waiverStatusKeys are the attributes of the waiver response read as the status of the waiver, in order. The waiver response
+// has no model in the ACS API, the status is only used for waiver_status and never to decide how a key is registered.
+var waiverStatusKeys = []string{"status", "waiverStatus"}
+
+// EmekKeyStatusPut returns StateRefreshFunc that makes PUT request and checks if response is accepted
+func EmekKeyStatusPut(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, keyARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.PutEmekKey(ctx, stack, v2.PutEmekKeyJSONRequestBody{KeyARN: keyARN})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// EmekPolicyStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the key policy response
+func EmekPolicyStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, legalAck string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetEmekPolicy(ctx, stack, &v2.GetEmekPolicyParams{EMEKLegalAck: legalAck})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var policy v2.EmekPolicy
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &policy); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &policy, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// EmekWaiverStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the waiver
+// attributes as strings. A stack without a waiver is read as an empty waiver.
+func EmekWaiverStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeEmekWaiver(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		waiver := map[string]string{}
+		if resp.StatusCode == http.StatusNotFound {
+			return waiver, http.StatusText(http.StatusOK), nil
+		}
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			if waiver, err = ParseWaiver(bodyBytes); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return waiver, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// ParseWaiver converts the waiver response, which has no fixed model, to a map of its top level attributes as strings.
+// Nested attributes are kept as JSON strings.
+func ParseWaiver(bodyBytes []byte) (map[string]string, error) {
+	waiver := map[string]string{}
+	if len(bodyBytes) == 0 {
+		return waiver, nil
+	}
+
+	var rawWaiver map[string]interface{}
+	if err := json.Unmarshal(bodyBytes, &rawWaiver); err != nil {
+		return nil, fmt.Errorf("invalid EMEK waiver response: %w", err)
+	}
+	for key, value := range rawWaiver {
+		switch v := value.(type) {
+		case nil:
+			waiver[key] = ""
+		case string:
+			waiver[key] = v
+		case map[string]interface{}, []interface{}:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			waiver[key] = string(encoded)
+		default:
+			waiver[key] = fmt.Sprint(v)
+		}
+	}
+	return waiver, nil
+}
+
+// WaiverStatus returns the status of the waiver, or an empty string if the waiver has no status
+func WaiverStatus(waiver map[string]string) string {
+	for _, key := range waiverStatusKeys {
+		if value, ok := waiver[key]; ok {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+package emek_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/splunk/terraform-provider-scp/internal/emek"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseWaiver(t *testing.T) {
+	t.Run("with nested and non string attributes", func(t *testing.T) {
+		waiver, err := emek.ParseWaiver([]byte(`{"waiverStatus":"pending","approved":false,"tickets":["CO-1"],"expiresAt":null}`))
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"waiverStatus": "pending", "approved": "false", "tickets": `["CO-1"]`, "expiresAt": ""}, waiver)
+		assert.Equal(t, "pending", emek.WaiverStatus(waiver))
+	})
+
+	t.Run("with empty body", func(t *testing.T) {
+		waiver, err := emek.ParseWaiver(nil)
+		assert.NoError(t, err)
+		assert.Empty(t, waiver)
+		assert.Equal(t, "", emek.WaiverStatus(waiver))
+	})
+
+	t.Run("with invalid body", func(t *testing.T) {
+		_, err := emek.ParseWaiver([]byte(`not json`))
+		assert.Error(t, err)
+	})
+}
+
+func genJSONResp(statusCode int, body interface{}) *http.Response {
+	b, _ := json.Marshal(body)
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
@@ -0,0 +1,125 @@
+package emek_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/emek"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack    = "mock-stack"
+	mockKeyARN   = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	mockLegalAck = "Y"
+)
+
+var (
+	unexpectedStatusCodes = []int{400, 401, 403, 404, 501, 503}
+
+	mockPolicy = v2.EmekPolicy{
+		Message: "apply the policy to the key",
+		Policy:  map[string]interface{}{"Version": "2012-10-17"},
+		Region:  "us-east-1",
+	}
+)
+
+func Test_WaitEmekKeyPut(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	body := v2.PutEmekKeyJSONRequestBody{KeyARN: mockKeyARN}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), body).Return(nil, errors.New("some error")).Once()
+		err := emek.WaitEmekKeyPut(context.TODO(), client, mockStack, mockKeyARN)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(http.StatusAccepted, `{"message":"key registered"}`), nil).Once()
+		err := emek.WaitEmekKeyPut(context.TODO(), client, mockStack, mockKeyARN)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := emek.WaitEmekKeyPut(context.TODO(), client, mockStack, mockKeyARN)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(statusCode, ""), nil).Once()
+				err := emek.WaitEmekKeyPut(context.TODO(), client, mockStack, mockKeyARN)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitEmekPolicyRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	params := &v2.GetEmekPolicyParams{EMEKLegalAck: mockLegalAck}
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, mockPolicy), nil).Once()
+		policy, err := emek.WaitEmekPolicyRead(context.TODO(), client, mockStack, mockLegalAck)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPolicy, *policy)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), params).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, mockPolicy), nil).Once()
+		policy, err := emek.WaitEmekPolicyRead(context.TODO(), client, mockStack, mockLegalAck)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPolicy, *policy)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), params).Return(genRawResp(statusCode, ""), nil).Once()
+				policy, err := emek.WaitEmekPolicyRead(context.TODO(), client, mockStack, mockLegalAck)
+				assert.Error(t, err)
+				assert.Nil(t, policy)
+			})
+		}
+	})
+}
+
+func Test_WaitEmekWaiverRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, `{"status":"approved","expiresAt":"2025-01-01"}`), nil).Once()
+		waiver, err := emek.WaitEmekWaiverRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"status": "approved", "expiresAt": "2025-01-01"}, waiver)
+	})
+
+	t.Run("with no waiver", func(t *testing.T) {
+		client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusNotFound, ""), nil).Once()
+		waiver, err := emek.WaitEmekWaiverRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Empty(t, waiver)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range []int{400, 401, 403, 501, 503} {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				waiver, err := emek.WaitEmekWaiverRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, waiver)
+			})
+		}
+	})
+}
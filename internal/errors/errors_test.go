@@ -81,3 +81,27 @@ func Test_IsConflictErr(t *testing.T) {
 		assert.True(t, got)
 	})
 }
+
+func Test_IsNotFoundErr(t *testing.T) {
+	t.Run("is not an Unexpected State Error", func(t *testing.T) {
+		err := fmt.Errorf("this is some random error")
+		got := IsNotFoundError(err)
+		assert.False(t, got)
+	})
+
+	t.Run("is not not found error", func(t *testing.T) {
+		err := &resource.UnexpectedStateError{
+			State: http.StatusText(http.StatusBadRequest),
+		}
+		got := IsNotFoundError(err)
+		assert.False(t, got)
+	})
+
+	t.Run("is not found error", func(t *testing.T) {
+		err := &resource.UnexpectedStateError{
+			State: http.StatusText(http.StatusNotFound),
+		}
+		got := IsNotFoundError(err)
+		assert.True(t, got)
+	})
+}
@@ -0,0 +1,586 @@
+package federated
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	GlueResourcesResourceKey = "scp_federated_glue_resources"
+
+	schemaKeyTable               = "table"
+	schemaKeyDatabase            = "database"
+	schemaKeyName                = "name"
+	schemaKeyFileFormat          = "file_format"
+	schemaKeyLocationPrefix      = "location_prefix"
+	schemaKeyCloudProvider       = "cloud_provider"
+	schemaKeySourceType          = "source_type"
+	schemaKeyFieldDelimiter      = "field_delimiter"
+	schemaKeyPartitionProjection = "partition_projection"
+	schemaKeyExtra               = "extra"
+	schemaKeyStatus              = "status"
+
+	schemaKeyAccountIDs       = "account_ids"
+	schemaKeyAccountIDKeyName = "account_id_key_name"
+	schemaKeyRegions          = "regions"
+	schemaKeyRegionKeyName    = "region_key_name"
+	schemaKeyTimeKeyName      = "time_key_name"
+	schemaKeyTimeDayKeyName   = "time_day_key_name"
+	schemaKeyTimeHourKeyName  = "time_hour_key_name"
+	schemaKeyTimeMonthKeyName = "time_month_key_name"
+	schemaKeyTimeRange        = "time_range"
+	schemaKeyTimeUnit         = "time_unit"
+
+	schemaKeyColumnIndexes  = "column_indexes"
+	schemaKeyOrgID          = "org_id"
+	schemaKeyPartitionStyle = "partition_style"
+
+	// DefaultCloudProvider is the cloud provider of the managed Glue resources of federated search for Amazon S3
+	DefaultCloudProvider = "aws"
+)
+
+func glueResourcesResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyTable: {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: glueTableSchema(),
+			},
+			Description: "The managed Glue tables. Each table is identified by its database and name, tables of the stack " +
+				"that are not configured here are left untouched.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the managed Glue resources reported by ACS after the last update.",
+		},
+	}
+}
+
+// glueTableSchema returns the attributes of a managed Glue table
+func glueTableSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyDatabase: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The name of the Glue database.",
+		},
+		schemaKeyName: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The name of the Glue table.",
+		},
+		schemaKeyFileFormat: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The file format of the data in the S3 location, e.g. parquet or json.",
+		},
+		schemaKeyLocationPrefix: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The S3 location prefix of the data of the table.",
+		},
+		schemaKeySourceType: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The source type of the data of the table.",
+		},
+		schemaKeyCloudProvider: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     DefaultCloudProvider,
+			Description: "The cloud provider of the data of the table. Defaults to aws.",
+		},
+		schemaKeyFieldDelimiter: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The field delimiter of delimited file formats.",
+		},
+		schemaKeyPartitionProjection: {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: partitionProjectionSchema(),
+			},
+			Description: "The partition projection of the table.",
+		},
+		schemaKeyExtra: {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: extraSchema(),
+			},
+			Description: "Additional settings of the table.",
+		},
+	}
+}
+
+// partitionProjectionSchema returns the attributes of the partition projection of a managed Glue table
+func partitionProjectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyAccountIDs: {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The AWS account IDs to project partitions for.",
+		},
+		schemaKeyAccountIDKeyName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the partition key of the account ID.",
+		},
+		schemaKeyRegions: {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The AWS regions to project partitions for.",
+		},
+		schemaKeyRegionKeyName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the partition key of the region.",
+		},
+		schemaKeyTimeKeyName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the partition key of the time.",
+		},
+		schemaKeyTimeDayKeyName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the partition key of the day.",
+		},
+		schemaKeyTimeHourKeyName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the partition key of the hour.",
+		},
+		schemaKeyTimeMonthKeyName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the partition key of the month.",
+		},
+		schemaKeyTimeRange: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The time range to project partitions for.",
+		},
+		schemaKeyTimeUnit: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The unit of the time partitions.",
+		},
+	}
+}
+
+// extraSchema returns the attributes of the additional settings of a managed Glue table
+func extraSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyColumnIndexes: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+			Description: "The indexes of the columns of the table.",
+		},
+		schemaKeyOrgID: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The ID of the AWS organization of the data.",
+		},
+		schemaKeyPartitionStyle: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The partition style of the data in the S3 location.",
+		},
+	}
+}
+
+func ResourceGlueResources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Federated Glue Resources Resource. Use this resource to manage the AWS Glue databases and tables that " +
+			"Federated Search for Amazon S3 manages for a stack. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/FederatedSearch/fsS3usecase " +
+			"for more latest, detailed information on attribute requirements and the ACS Federated Search API.",
+
+		CreateContext: resourceGlueResourcesCreate,
+		ReadContext:   resourceGlueResourcesRead,
+		UpdateContext: resourceGlueResourcesUpdate,
+		DeleteContext: resourceGlueResourcesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceGlueResourcesCustomizeDiff,
+
+		Schema: glueResourcesResourceSchema(),
+	}
+}
+
+func resourceGlueResourcesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	upserts, err := expandGlueTables(d.Get(schemaKeyTable).(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := WaitGlueResourcesApply(ctx, acsClient, stack, upserts, nil); err != nil {
+		return diag.Errorf("Error submitting request for managed Glue resources to be created: %s", err)
+	}
+
+	//Poll until the update has settled
+	if err := WaitVerifyGlueResourcesUpdate(ctx, acsClient, stack, upserts, nil); err != nil {
+		return diag.Errorf("Error waiting for managed Glue resources to be created: %s", err)
+	}
+
+	// The managed Glue resources are a single configuration of the stack, the stack is used as ID of the resource
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created managed Glue resources resource: %s\n", stack))
+
+	return resourceGlueResourcesRead(ctx, d, m)
+}
+
+func resourceGlueResourcesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	glueResources, err := WaitGlueResourcesRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading managed Glue resources (%s): %s", d.Id(), err)
+	}
+
+	// Only the managed tables are tracked, on import all tables of the stack are tracked
+	current := glueResourcesValue(glueResources)
+	tables := current
+	if managedTables, err := expandGlueTables(d.Get(schemaKeyTable).(*schema.Set).List()); err == nil && len(managedTables) > 0 {
+		tables = make([]v2.ManagedGlueResources, 0, len(managedTables))
+		for _, managedTable := range managedTables {
+			if glueResource := FindGlueResource(current, KeyOf(managedTable)); glueResource != nil {
+				tables = append(tables, *glueResource)
+			}
+		}
+	}
+
+	if err := d.Set(schemaKeyTable, flattenGlueTables(tables)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateStatus := ""
+	if glueResources.Status != nil {
+		updateStatus = *glueResources.Status
+	}
+	if err := d.Set(schemaKeyStatus, updateStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGlueResourcesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChange(schemaKeyTable) {
+		oldTables, newTables := d.GetChange(schemaKeyTable)
+		upserts, removed, err := DiffGlueTables(oldTables.(*schema.Set), newTables.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := WaitGlueResourcesApply(ctx, acsClient, stack, upserts, removed); err != nil {
+			return diag.Errorf("Error updating managed Glue resources (%s): %s", d.Id(), err)
+		}
+
+		//Poll until the update has settled
+		if err := WaitVerifyGlueResourcesUpdate(ctx, acsClient, stack, upserts, removed); err != nil {
+			return diag.Errorf("Error waiting for managed Glue resources (%s) to be updated: %s", d.Id(), err)
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Updated managed Glue resources resource: %d tables changed, %d tables removed\n", len(upserts), len(removed)))
+	}
+
+	return resourceGlueResourcesRead(ctx, d, m)
+}
+
+func resourceGlueResourcesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	tables, err := expandGlueTables(d.Get(schemaKeyTable).(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	removed := make([]GlueTableKey, 0, len(tables))
+	for _, table := range tables {
+		removed = append(removed, KeyOf(table))
+	}
+
+	if err := WaitGlueResourcesApply(ctx, acsClient, stack, nil, removed); err != nil {
+		return diag.Errorf("Error deleting managed Glue resources (%s): %s", d.Id(), err)
+	}
+
+	//Poll until the tables have been confirmed removed
+	if err := WaitVerifyGlueResourcesUpdate(ctx, acsClient, stack, nil, removed); err != nil {
+		return diag.Errorf("Error waiting for managed Glue resources (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted managed Glue resources resource: %s\n", d.Id()))
+	return nil
+}
+
+// DiffGlueTables returns the tables that were added or changed and the keys of the tables that were removed, unchanged
+// tables are neither upserted nor removed
+func DiffGlueTables(oldTables *schema.Set, newTables *schema.Set) ([]v2.ManagedGlueResources, []GlueTableKey, error) {
+	upserts, err := expandGlueTables(newTables.Difference(oldTables).List())
+	if err != nil {
+		return nil, nil, err
+	}
+	remaining, err := expandGlueTables(newTables.List())
+	if err != nil {
+		return nil, nil, err
+	}
+	dropped, err := expandGlueTables(oldTables.Difference(newTables).List())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removed := make([]GlueTableKey, 0)
+	for _, table := range dropped {
+		// a changed table is dropped from the old set but still configured under the same key
+		if FindGlueResource(remaining, KeyOf(table)) == nil {
+			removed = append(removed, KeyOf(table))
+		}
+	}
+	return upserts, removed, nil
+}
+
+// resourceGlueResourcesCustomizeDiff fails the plan if a table has an empty extra block, which ACS does not return so that
+// the block would be planned again on every apply. The raw config is checked as nested blocks of set elements can not be
+// read from the diff.
+func resourceGlueResourcesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	tables := d.GetRawConfig()
+	if tables.IsNull() || !tables.IsKnown() || !tables.Type().HasAttribute(schemaKeyTable) {
+		return nil
+	}
+	tables = tables.GetAttr(schemaKeyTable)
+	if tables.IsNull() || !tables.IsKnown() {
+		return nil
+	}
+
+	for it := tables.ElementIterator(); it.Next(); {
+		_, table := it.Element()
+		if table.IsNull() || !table.IsKnown() || !table.Type().HasAttribute(schemaKeyExtra) {
+			continue
+		}
+		extras := table.GetAttr(schemaKeyExtra)
+		if extras.IsNull() || !extras.IsKnown() || extras.LengthInt() == 0 {
+			continue
+		}
+		if isEmptyBlock(extras.Index(cty.NumberIntVal(0)), schemaKeyColumnIndexes, schemaKeyOrgID, schemaKeyPartitionStyle) {
+			return fmt.Errorf("extra of table (%s/%s) must set at least one of %s, %s or %s, remove the block if no additional settings are needed",
+				rawString(table, schemaKeyDatabase), rawString(table, schemaKeyName), schemaKeyColumnIndexes, schemaKeyOrgID, schemaKeyPartitionStyle)
+		}
+	}
+	return nil
+}
+
+// isEmptyBlock returns true if none of the given attributes of a raw config block is set to a non-empty value
+func isEmptyBlock(block cty.Value, attributes ...string) bool {
+	if block.IsNull() {
+		return true
+	}
+	if !block.IsKnown() {
+		return false
+	}
+	for _, attribute := range attributes {
+		if !block.Type().HasAttribute(attribute) {
+			continue
+		}
+		value := block.GetAttr(attribute)
+		switch {
+		case value.IsNull():
+			continue
+		case !value.IsKnown():
+			return false
+		case value.Type() == cty.String:
+			if value.AsString() != "" {
+				return false
+			}
+		case value.CanIterateElements():
+			if value.LengthInt() > 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// rawString returns the string attribute of a raw config block, or an empty string if it is not set or not known
+func rawString(block cty.Value, attribute string) string {
+	if !block.Type().HasAttribute(attribute) {
+		return ""
+	}
+	value := block.GetAttr(attribute)
+	if value.IsNull() || !value.IsKnown() || value.Type() != cty.String {
+		return ""
+	}
+	return value.AsString()
+}
+
+// expandGlueTables converts the table blocks to managed Glue resources and fails if a database and table is configured twice
+func expandGlueTables(rawTables []interface{}) ([]v2.ManagedGlueResources, error) {
+	tables := make([]v2.ManagedGlueResources, 0, len(rawTables))
+	for _, rawTable := range rawTables {
+		table := expandGlueTable(rawTable.(map[string]interface{}))
+		if FindGlueResource(tables, KeyOf(table)) != nil {
+			return nil, fmt.Errorf("table (%s) is configured more than once", KeyOf(table))
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+func expandGlueTable(rawTable map[string]interface{}) v2.ManagedGlueResources {
+	table := v2.ManagedGlueResources{
+		Database:       rawTable[schemaKeyDatabase].(string),
+		Table:          rawTable[schemaKeyName].(string),
+		FileFormat:     rawTable[schemaKeyFileFormat].(string),
+		LocationPrefix: rawTable[schemaKeyLocationPrefix].(string),
+		SourceType:     rawTable[schemaKeySourceType].(string),
+		CloudProvider:  rawTable[schemaKeyCloudProvider].(string),
+		FieldDelimiter: optionalString(rawTable[schemaKeyFieldDelimiter]),
+	}
+
+	if rawProjections := rawTable[schemaKeyPartitionProjection].([]interface{}); len(rawProjections) > 0 && rawProjections[0] != nil {
+		rawProjection := rawProjections[0].(map[string]interface{})
+		table.PartitionProjection = v2.PartitionProjection{
+			AccountIDs:       expandStrings(rawProjection[schemaKeyAccountIDs].([]interface{})),
+			AccountIDKeyName: optionalString(rawProjection[schemaKeyAccountIDKeyName]),
+			Regions:          expandStrings(rawProjection[schemaKeyRegions].([]interface{})),
+			RegionKeyName:    optionalString(rawProjection[schemaKeyRegionKeyName]),
+			TimeKeyName:      optionalString(rawProjection[schemaKeyTimeKeyName]),
+			TimeDayKeyName:   optionalString(rawProjection[schemaKeyTimeDayKeyName]),
+			TimeHourKeyName:  optionalString(rawProjection[schemaKeyTimeHourKeyName]),
+			TimeMonthKeyName: optionalString(rawProjection[schemaKeyTimeMonthKeyName]),
+			TimeRange:        optionalString(rawProjection[schemaKeyTimeRange]),
+			TimeUnit:         optionalString(rawProjection[schemaKeyTimeUnit]),
+		}
+	}
+
+	if rawExtras := rawTable[schemaKeyExtra].([]interface{}); len(rawExtras) > 0 && rawExtras[0] != nil {
+		rawExtra := rawExtras[0].(map[string]interface{})
+		extra := v2.Extra{
+			OrgID:          optionalString(rawExtra[schemaKeyOrgID]),
+			PartitionStyle: optionalString(rawExtra[schemaKeyPartitionStyle]),
+		}
+		if rawColumnIndexes := rawExtra[schemaKeyColumnIndexes].([]interface{}); len(rawColumnIndexes) > 0 {
+			columnIndexes := make([]int, 0, len(rawColumnIndexes))
+			for _, columnIndex := range rawColumnIndexes {
+				columnIndexes = append(columnIndexes, columnIndex.(int))
+			}
+			extra.ColumnIndexes = &columnIndexes
+		}
+		table.Extra = &extra
+	}
+
+	return table
+}
+
+func flattenGlueTables(tables []v2.ManagedGlueResources) []interface{} {
+	flattenedTables := make([]interface{}, 0, len(tables))
+	for _, table := range tables {
+		projection := table.PartitionProjection
+		flattenedTable := map[string]interface{}{
+			schemaKeyDatabase:       table.Database,
+			schemaKeyName:           table.Table,
+			schemaKeyFileFormat:     table.FileFormat,
+			schemaKeyLocationPrefix: table.LocationPrefix,
+			schemaKeySourceType:     table.SourceType,
+			schemaKeyCloudProvider:  table.CloudProvider,
+			schemaKeyFieldDelimiter: stringValue(table.FieldDelimiter),
+			schemaKeyPartitionProjection: []interface{}{map[string]interface{}{
+				schemaKeyAccountIDs:       projection.AccountIDs,
+				schemaKeyAccountIDKeyName: stringValue(projection.AccountIDKeyName),
+				schemaKeyRegions:          projection.Regions,
+				schemaKeyRegionKeyName:    stringValue(projection.RegionKeyName),
+				schemaKeyTimeKeyName:      stringValue(projection.TimeKeyName),
+				schemaKeyTimeDayKeyName:   stringValue(projection.TimeDayKeyName),
+				schemaKeyTimeHourKeyName:  stringValue(projection.TimeHourKeyName),
+				schemaKeyTimeMonthKeyName: stringValue(projection.TimeMonthKeyName),
+				schemaKeyTimeRange:        stringValue(projection.TimeRange),
+				schemaKeyTimeUnit:         stringValue(projection.TimeUnit),
+			}},
+			schemaKeyExtra: []interface{}{},
+		}
+		if extra := normalizeGlueResource(table).Extra; extra != nil {
+			columnIndexes := []int{}
+			if extra.ColumnIndexes != nil {
+				columnIndexes = *extra.ColumnIndexes
+			}
+			flattenedTable[schemaKeyExtra] = []interface{}{map[string]interface{}{
+				schemaKeyColumnIndexes:  columnIndexes,
+				schemaKeyOrgID:          stringValue(extra.OrgID),
+				schemaKeyPartitionStyle: stringValue(extra.PartitionStyle),
+			}}
+		}
+		flattenedTables = append(flattenedTables, flattenedTable)
+	}
+	return flattenedTables
+}
+
+func optionalString(value interface{}) *string {
+	parsedValue, ok := value.(string)
+	if !ok || parsedValue == "" {
+		return nil
+	}
+	return &parsedValue
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func expandStrings(rawValues []interface{}) []string {
+	values := make([]string, 0, len(rawValues))
+	for _, value := range rawValues {
+		values = append(values, value.(string))
+	}
+	return values
+}
@@ -0,0 +1,141 @@
+package federated_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/federated"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DiffGlueTables(t *testing.T) {
+	tableResource := federated.ResourceGlueResources().Schema["table"].Elem.(*schema.Resource)
+	genTable := func(name string, fileFormat string) map[string]interface{} {
+		return map[string]interface{}{
+			"database":        mockDatabase,
+			"name":            name,
+			"file_format":     fileFormat,
+			"location_prefix": "s3://mock-bucket/" + name + "/",
+			"source_type":     "aws:cloudtrail",
+			"cloud_provider":  "aws",
+			"field_delimiter": "",
+			"partition_projection": []interface{}{map[string]interface{}{
+				"account_ids": []interface{}{"123456789012"},
+				"regions":     []interface{}{"us-east-1"},
+				"time_unit":   "days",
+			}},
+			"extra": []interface{}{},
+		}
+	}
+	oldTables := schema.NewSet(schema.HashResource(tableResource), []interface{}{genTable("cloudtrail", "parquet"), genTable("vpc_flow", "parquet"), genTable("waf", "parquet")})
+	newTables := schema.NewSet(schema.HashResource(tableResource), []interface{}{genTable("cloudtrail", "json"), genTable("vpc_flow", "parquet"), genTable("route53", "parquet")})
+
+	upserts, removed, err := federated.DiffGlueTables(oldTables, newTables)
+	assert.NoError(t, err)
+
+	upsertKeys := make([]federated.GlueTableKey, 0, len(upserts))
+	for _, upsert := range upserts {
+		upsertKeys = append(upsertKeys, federated.KeyOf(upsert))
+	}
+	// the unchanged vpc_flow table is neither upserted nor removed
+	assert.ElementsMatch(t, []federated.GlueTableKey{{Database: mockDatabase, Table: "cloudtrail"}, {Database: mockDatabase, Table: "route53"}}, upsertKeys)
+	assert.Equal(t, []federated.GlueTableKey{{Database: mockDatabase, Table: "waf"}}, removed)
+	assert.Equal(t, "json", federated.FindGlueResource(upserts, federated.GlueTableKey{Database: mockDatabase, Table: "cloudtrail"}).FileFormat)
+	assert.Equal(t, []string{"123456789012"}, federated.FindGlueResource(upserts, federated.GlueTableKey{Database: mockDatabase, Table: "route53"}).PartitionProjection.AccountIDs)
+	assert.Nil(t, federated.FindGlueResource(upserts, federated.GlueTableKey{Database: mockDatabase, Table: "route53"}).Extra)
+}
+
+func Test_ResourceGlueResourcesCustomizeDiff(t *testing.T) {
+	glueResources := federated.ResourceGlueResources()
+	genDiff := func(extra string) error {
+		rawConfig, err := ctyjson.Unmarshal([]byte(fmt.Sprintf(`{"table": [{
+			"database": %q,
+			"name": "cloudtrail",
+			"file_format": "parquet",
+			"location_prefix": "s3://mock-bucket/cloudtrail/",
+			"source_type": "aws:cloudtrail",
+			"partition_projection": [{"account_ids": ["123456789012"], "regions": ["us-east-1"]}],
+			"extra": [%s]
+		}]}`, mockDatabase, extra)), glueResources.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			return err
+		}
+		config := terraform.NewResourceConfigShimmed(rawConfig, glueResources.CoreConfigSchema())
+		_, err = glueResources.Diff(context.TODO(), &terraform.InstanceState{RawConfig: rawConfig}, config, nil)
+		return err
+	}
+
+	t.Run("with empty extra block", func(t *testing.T) {
+		assert.ErrorContains(t, genDiff(`{}`), "extra of table (security_lake/cloudtrail) must set at least one of")
+	})
+
+	t.Run("with empty values in extra block", func(t *testing.T) {
+		assert.ErrorContains(t, genDiff(`{"org_id": "", "column_indexes": []}`), "must set at least one of")
+	})
+
+	t.Run("with extra block", func(t *testing.T) {
+		assert.NoError(t, genDiff(`{"org_id": "o-mock"}`))
+	})
+
+	t.Run("without extra block", func(t *testing.T) {
+		assert.NoError(t, genDiff(``))
+	})
+}
+
+// preCheckGlue skips the test if no S3 location with federated search access is known to define the table on
+func preCheckGlue(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("FEDERATED_GLUE_LOCATION_PREFIX") == "" || os.Getenv("FEDERATED_GLUE_ACCOUNT_ID") == "" {
+		t.Skip("`FEDERATED_GLUE_LOCATION_PREFIX` and `FEDERATED_GLUE_ACCOUNT_ID` must be set for federated Glue resources acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudFederatedGlueResources(t *testing.T) {
+	resourceName := resource.UniqueId()
+	locationPrefix := os.Getenv("FEDERATED_GLUE_LOCATION_PREFIX")
+	accountID := os.Getenv("FEDERATED_GLUE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckGlue(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create managed Glue table
+			{
+				Config: testAccInstanceConfigGlueResources(resourceName, locationPrefix, accountID, "parquet"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("scp_federated_glue_resources.%s", resourceName), "table.#", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("scp_federated_glue_resources.%s", resourceName), "table.0.file_format", "parquet"),
+				),
+			},
+			// Update managed Glue table
+			{
+				Config: testAccInstanceConfigGlueResources(resourceName, locationPrefix, accountID, "json"),
+				Check:  resource.TestCheckResourceAttr(fmt.Sprintf("scp_federated_glue_resources.%s", resourceName), "table.0.file_format", "json"),
+			},
+		},
+	})
+}
+
+func testAccInstanceConfigGlueResources(resourceName string, locationPrefix string, accountID string, fileFormat string) string {
+	return fmt.Sprintf(`resource "scp_federated_glue_resources" %[1]q {
+		table {
+			database        = "terraform_acceptance"
+			name            = %[1]q
+			file_format     = %[4]q
+			location_prefix = %[2]q
+			source_type     = "aws:cloudtrail"
+
+			partition_projection {
+				account_ids = [%[3]q]
+				regions     = ["us-east-1"]
+			}
+		}
+	}`, resourceName, locationPrefix, accountID, fileFormat)
+}
@@ -0,0 +1,217 @@
+package federated
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// GlueTableKey identifies a managed Glue resource by its database and table
+type GlueTableKey struct {
+	Database string
+	Table    string
+}
+
+func (k GlueTableKey) String() string {
+	return fmt.Sprintf("%s.%s", k.Database, k.Table)
+}
+
+// KeyOf returns the key of a managed Glue resource
+func KeyOf(glueResource v2.ManagedGlueResources) GlueTableKey {
+	return GlueTableKey{Database: glueResource.Database, Table: glueResource.Table}
+}
+
+// GlueResourcesStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the managed Glue resources response
+func GlueResourcesStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeManagedGlueResources(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var glueResources v2.DescribeManagedGlueResources
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &glueResources); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &glueResources, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// GlueResourcesStatusUpdate returns StateRefreshFunc that makes PUT request with all managed Glue resources and checks if request was accepted
+func GlueResourcesStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, glueResources []v2.ManagedGlueResources) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.UpdateManagedGlueResources(ctx, stack, v2.UpdateManagedGlueResourcesJSONRequestBody{ManagedGlueResources: &glueResources})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// GlueResourcesStatusVerify returns a StateRefreshFunc that makes a GET request and checks if the update of the managed Glue
+// resources has settled, that is the upserted tables match and the removed tables are absent once ACS no longer reports
+// the update as in progress
+func GlueResourcesStatusVerify(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, upserts []v2.ManagedGlueResources, removed []GlueTableKey) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := GlueResourcesStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+		glueResources := output.(*v2.DescribeManagedGlueResources)
+
+		updateStatus := ""
+		if glueResources.Status != nil {
+			updateStatus = *glueResources.Status
+		}
+		if !IsGlueStatusPending(updateStatus) && !IsGlueStatusComplete(updateStatus) {
+			return nil, updateStatus, &resource.UnexpectedStateError{
+				State:         updateStatus,
+				ExpectedState: []string{status.UpdatedStatus},
+				LastError:     fmt.Errorf("update of managed Glue resources failed with status %s", updateStatus),
+			}
+		}
+		if IsGlueStatusPending(updateStatus) || !IsGlueResourcesApplied(glueResourcesValue(glueResources), upserts, removed) {
+			return glueResources, GlueStatusSettling, nil
+		}
+		return glueResources, status.UpdatedStatus, nil
+	}
+}
+
+// MergeGlueResources returns the current managed Glue resources with the removed tables dropped and the upserted tables
+// replacing the current table of the same database and table or appended, so tables not managed by terraform are kept
+func MergeGlueResources(current []v2.ManagedGlueResources, upserts []v2.ManagedGlueResources, removed []GlueTableKey) []v2.ManagedGlueResources {
+	merged := make([]v2.ManagedGlueResources, 0, len(current)+len(upserts))
+	replaced := map[GlueTableKey]bool{}
+	for _, glueResource := range current {
+		key := KeyOf(glueResource)
+		if slices.Contains(removed, key) {
+			continue
+		}
+		if upsert := FindGlueResource(upserts, key); upsert != nil {
+			merged = append(merged, *upsert)
+			replaced[key] = true
+			continue
+		}
+		merged = append(merged, glueResource)
+	}
+	for _, upsert := range upserts {
+		if !replaced[KeyOf(upsert)] {
+			merged = append(merged, upsert)
+		}
+	}
+	return merged
+}
+
+// FindGlueResource returns the managed Glue resource with the given key, or nil if there is none
+func FindGlueResource(glueResources []v2.ManagedGlueResources, key GlueTableKey) *v2.ManagedGlueResources {
+	for i := range glueResources {
+		if KeyOf(glueResources[i]) == key {
+			return &glueResources[i]
+		}
+	}
+	return nil
+}
+
+// IsGlueResourcesApplied returns true if every upserted table is equal to the current table and every removed table is absent
+func IsGlueResourcesApplied(current []v2.ManagedGlueResources, upserts []v2.ManagedGlueResources, removed []GlueTableKey) bool {
+	for _, upsert := range upserts {
+		glueResource := FindGlueResource(current, KeyOf(upsert))
+		if glueResource == nil || !IsGlueResourceEqual(*glueResource, upsert) {
+			return false
+		}
+	}
+	for _, key := range removed {
+		if FindGlueResource(current, key) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// IsGlueResourceEqual compares two managed Glue resources, treating unset and empty optional attributes as equal
+func IsGlueResourceEqual(a v2.ManagedGlueResources, b v2.ManagedGlueResources) bool {
+	encodedA, errA := json.Marshal(normalizeGlueResource(a))
+	encodedB, errB := json.Marshal(normalizeGlueResource(b))
+	return errA == nil && errB == nil && string(encodedA) == string(encodedB)
+}
+
+// IsGlueStatusPending returns true if the status of the managed Glue resources reports an update in progress
+func IsGlueStatusPending(updateStatus string) bool {
+	return slices.Contains(GlueStatusPending, updateStatus)
+}
+
+// IsGlueStatusComplete returns true if the status of the managed Glue resources reports no update in progress or a
+// completed update
+func IsGlueStatusComplete(updateStatus string) bool {
+	return slices.Contains(GlueStatusComplete, updateStatus)
+}
+
+func normalizeGlueResource(glueResource v2.ManagedGlueResources) v2.ManagedGlueResources {
+	glueResource.FieldDelimiter = nilIfEmpty(glueResource.FieldDelimiter)
+	if glueResource.Extra != nil {
+		extra := *glueResource.Extra
+		extra.OrgID = nilIfEmpty(extra.OrgID)
+		extra.PartitionStyle = nilIfEmpty(extra.PartitionStyle)
+		if extra.ColumnIndexes != nil && len(*extra.ColumnIndexes) == 0 {
+			extra.ColumnIndexes = nil
+		}
+		glueResource.Extra = &extra
+		if extra == (v2.Extra{}) {
+			glueResource.Extra = nil
+		}
+	}
+	projection := glueResource.PartitionProjection
+	for _, value := range []**string{&projection.AccountIDKeyName, &projection.RegionKeyName, &projection.TimeDayKeyName,
+		&projection.TimeHourKeyName, &projection.TimeKeyName, &projection.TimeMonthKeyName, &projection.TimeRange, &projection.TimeUnit} {
+		*value = nilIfEmpty(*value)
+	}
+	if projection.AccountIDs == nil {
+		projection.AccountIDs = []string{}
+	}
+	if projection.Regions == nil {
+		projection.Regions = []string{}
+	}
+	glueResource.PartitionProjection = projection
+	return glueResource
+}
+
+func nilIfEmpty(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
+
+func glueResourcesValue(glueResources *v2.DescribeManagedGlueResources) []v2.ManagedGlueResources {
+	if glueResources.ManagedGlueResources == nil {
+		return []v2.ManagedGlueResources{}
+	}
+	return *glueResources.ManagedGlueResources
+}
@@ -0,0 +1,132 @@
+package federated_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/federated"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_MergeGlueResources(t *testing.T) {
+	unmanaged := genGlueResource("other_db", "flows")
+	current := []v2.ManagedGlueResources{unmanaged, genGlueResource(mockDatabase, "cloudtrail"), genGlueResource(mockDatabase, "vpc_flow")}
+
+	changed := genGlueResource(mockDatabase, "cloudtrail")
+	changed.FileFormat = "json"
+	added := genGlueResource(mockDatabase, "waf")
+
+	merged := federated.MergeGlueResources(current, []v2.ManagedGlueResources{changed, added}, []federated.GlueTableKey{{Database: mockDatabase, Table: "vpc_flow"}})
+	assert.Equal(t, []v2.ManagedGlueResources{unmanaged, changed, added}, merged)
+}
+
+func Test_IsGlueResourceEqual(t *testing.T) {
+	table := genGlueResource(mockDatabase, "cloudtrail")
+
+	empty := ""
+	withEmptyOptionals := table
+	withEmptyOptionals.FieldDelimiter = &empty
+	withEmptyOptionals.Extra = &v2.Extra{OrgID: &empty}
+	assert.True(t, federated.IsGlueResourceEqual(table, withEmptyOptionals))
+
+	changed := table
+	changed.LocationPrefix = "s3://other-bucket/"
+	assert.False(t, federated.IsGlueResourceEqual(table, changed))
+}
+
+func Test_GlueStatus(t *testing.T) {
+	assert.True(t, federated.IsGlueStatusPending("running"))
+	assert.False(t, federated.IsGlueStatusPending("completed"))
+	assert.True(t, federated.IsGlueStatusComplete("completed"))
+	assert.True(t, federated.IsGlueStatusComplete(""))
+	assert.False(t, federated.IsGlueStatusComplete("failed"))
+	assert.False(t, federated.IsGlueStatusPending("UPDATE_FAILED"))
+	assert.False(t, federated.IsGlueStatusComplete("UPDATE_FAILED"))
+}
+
+func Test_GlueResourcesStatusVerify(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	table := genGlueResource(mockDatabase, "cloudtrail")
+	upserts := []v2.ManagedGlueResources{table}
+
+	t.Run("with update in progress", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "running", upserts), nil).Once()
+		output, statusText, err := federated.GlueResourcesStatusVerify(context.TODO(), client, mockStack, upserts, nil)()
+		assert.NoError(t, err)
+		assert.NotNil(t, output)
+		assert.Equal(t, federated.GlueStatusSettling, statusText)
+	})
+
+	t.Run("with table not applied yet", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", nil), nil).Once()
+		_, statusText, err := federated.GlueResourcesStatusVerify(context.TODO(), client, mockStack, upserts, nil)()
+		assert.NoError(t, err)
+		assert.Equal(t, federated.GlueStatusSettling, statusText)
+	})
+
+	t.Run("with removed table still present", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", upserts), nil).Once()
+		_, statusText, err := federated.GlueResourcesStatusVerify(context.TODO(), client, mockStack, nil, []federated.GlueTableKey{federated.KeyOf(table)})()
+		assert.NoError(t, err)
+		assert.Equal(t, federated.GlueStatusSettling, statusText)
+	})
+
+	t.Run("with update settled", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", upserts), nil).Once()
+		_, statusText, err := federated.GlueResourcesStatusVerify(context.TODO(), client, mockStack, upserts, nil)()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, statusText)
+	})
+
+	t.Run("with update failed", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "failed", nil), nil).Once()
+		_, _, err := federated.GlueResourcesStatusVerify(context.TODO(), client, mockStack, upserts, nil)()
+		assert.ErrorContains(t, err, "failed")
+	})
+
+	t.Run("with unknown status", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "UPDATE_FAILED", nil), nil).Once()
+		_, _, err := federated.GlueResourcesStatusVerify(context.TODO(), client, mockStack, upserts, nil)()
+		assert.ErrorContains(t, err, "UPDATE_FAILED")
+	})
+}
+
+func genGlueResource(database string, table string) v2.ManagedGlueResources {
+	timeUnit := "days"
+	return v2.ManagedGlueResources{
+		CloudProvider:  "aws",
+		Database:       database,
+		Table:          table,
+		FileFormat:     "parquet",
+		LocationPrefix: "s3://mock-bucket/" + table + "/",
+		SourceType:     "aws:cloudtrail",
+		PartitionProjection: v2.PartitionProjection{
+			AccountIDs: []string{"123456789012"},
+			Regions:    []string{"us-east-1"},
+			TimeUnit:   &timeUnit,
+		},
+	}
+}
+
+func genGlueResourcesResp(statusCode int, updateStatus string, glueResources []v2.ManagedGlueResources) *http.Response {
+	if statusCode != http.StatusOK {
+		return genRawResp(statusCode, "")
+	}
+	b, _ := json.Marshal(v2.DescribeManagedGlueResources{ManagedGlueResources: &glueResources, Status: &updateStatus})
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
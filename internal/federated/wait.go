@@ -0,0 +1,85 @@
+package federated
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	// GlueStatusSettling is the state of the managed Glue resources while an update has not been applied yet
+	GlueStatusSettling = "settling"
+)
+
+var (
+	// Managed Glue resources updates are either processed synchronously (200) or accepted (202)
+	TargetStatusResourceChange = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusAccepted)}
+
+	// Managed Glue resources are read until ACS no longer reports the update as in progress and the update has been applied
+	PendingStatusVerifyUpdated = []string{GlueStatusSettling, http.StatusText(http.StatusTooManyRequests)}
+
+	// Statuses of the managed Glue resources, ACS reports an update as new, pending or running until it is completed or
+	// failed. No status is reported before the first update, any other status is a failed update.
+	GlueStatusPending  = []string{"new", "pending", "running"}
+	GlueStatusComplete = []string{"", "completed"}
+)
+
+// WaitGlueResourcesRead Handles retry logic for GET requests reading the managed Glue resources
+func WaitGlueResourcesRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.DescribeManagedGlueResources, error) {
+	waitGlueResourcesRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, GlueResourcesStatusRead(ctx, acsClient, stack))
+
+	output, err := waitGlueResourcesRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading managed Glue resources: %s", err))
+		return nil, err
+	}
+	glueResources := output.(*v2.DescribeManagedGlueResources)
+
+	return glueResources, nil
+}
+
+// WaitGlueResourcesApply reads the current managed Glue resources, merges the upserted and removed tables and handles retry
+// logic for the PUT request updating all managed Glue resources for the create, update and delete lifecycle functions
+func WaitGlueResourcesApply(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, upserts []v2.ManagedGlueResources, removed []GlueTableKey) error {
+	current, err := WaitGlueResourcesRead(ctx, acsClient, stack)
+	if err != nil {
+		return err
+	}
+	merged := MergeGlueResources(glueResourcesValue(current), upserts, removed)
+
+	waitGlueResourcesUpdateAccepted := wait.GenerateWriteStateChangeConf(GlueResourcesStatusUpdate(ctx, acsClient, stack, merged))
+	waitGlueResourcesUpdateAccepted.Target = TargetStatusResourceChange
+
+	rawResp, err := waitGlueResourcesUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for managed Glue resources to be updated: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for managed Glue resources: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for managed Glue resources: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyGlueResourcesUpdate waits until the update of the managed Glue resources has settled with the upserted tables
+// applied and the removed tables absent
+func WaitVerifyGlueResourcesUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, upserts []v2.ManagedGlueResources, removed []GlueTableKey) error {
+	waitGlueResourcesUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, GlueResourcesStatusVerify(ctx, acsClient, stack, upserts, removed))
+
+	_, err := waitGlueResourcesUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for managed Glue resources to be updated: %s", err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package federated_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/federated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack    = "mock-stack"
+	mockDatabase = "security_lake"
+)
+
+var (
+	unexpectedStatusCodes = []int{400, 401, 403, 404, 501, 503}
+)
+
+func Test_WaitGlueResourcesRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	tables := []v2.ManagedGlueResources{genGlueResource(mockDatabase, "cloudtrail")}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		glueResources, err := federated.WaitGlueResourcesRead(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, glueResources)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusTooManyRequests, "", nil), nil).Once()
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", tables), nil).Once()
+		glueResources, err := federated.WaitGlueResourcesRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, tables, *glueResources.ManagedGlueResources)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(statusCode, "", nil), nil).Once()
+				glueResources, err := federated.WaitGlueResourcesRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, glueResources)
+			})
+		}
+	})
+}
+
+func Test_WaitGlueResourcesApply(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	unmanaged := genGlueResource("other_db", "flows")
+	added := genGlueResource(mockDatabase, "cloudtrail")
+	expectedBody := v2.UpdateManagedGlueResourcesJSONRequestBody{ManagedGlueResources: &[]v2.ManagedGlueResources{unmanaged, added}}
+
+	t.Run("with unmanaged tables kept", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", []v2.ManagedGlueResources{unmanaged}), nil).Once()
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), expectedBody).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := federated.WaitGlueResourcesApply(context.TODO(), client, mockStack, []v2.ManagedGlueResources{added}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", []v2.ManagedGlueResources{unmanaged}), nil).Once()
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), expectedBody).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), expectedBody).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := federated.WaitGlueResourcesApply(context.TODO(), client, mockStack, []v2.ManagedGlueResources{added}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", []v2.ManagedGlueResources{unmanaged}), nil).Once()
+				client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), expectedBody).Return(genRawResp(statusCode, ""), nil).Once()
+				err := federated.WaitGlueResourcesApply(context.TODO(), client, mockStack, []v2.ManagedGlueResources{added}, nil)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyGlueResourcesUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	upserts := []v2.ManagedGlueResources{genGlueResource(mockDatabase, "cloudtrail")}
+
+	t.Run("with update settling", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "running", nil), nil).Once()
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "completed", upserts), nil).Once()
+		err := federated.WaitVerifyGlueResourcesUpdate(context.TODO(), client, mockStack, upserts, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with update failed", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResourcesResp(http.StatusOK, "FAILED", nil), nil).Once()
+		err := federated.WaitVerifyGlueResourcesUpdate(context.TODO(), client, mockStack, upserts, nil)
+		assert.Error(t, err)
+	})
+}
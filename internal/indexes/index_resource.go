@@ -55,7 +55,8 @@ func indexResourceSchema() map[string]*schema.Schema {
 			Description: "To create an index with DDSS enabled, you must specify the selfStorageBucketPath value in the following format:" +
 				" \"s3://selfStorageBucket/selfStorageBucketFolder\", where SelfStorageBucketFolder is optional, as you " +
 				"can store data buckets at root. Before you can create an index with DDSS enabled, you must configure a self-storage location " +
-				"for your deployment (see https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations). Can not be set with splunk_archival_retention_days. ",
+				"for your deployment (see https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations), for example by referencing " +
+				"the bucket_path of an scp_self_storage_location resource. Can not be set with splunk_archival_retention_days. ",
 		},
 		"splunk_archival_retention_days": {
 			Type:          schema.TypeFloat,
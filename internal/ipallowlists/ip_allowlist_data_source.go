@@ -0,0 +1,125 @@
+package ipallowlists
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	DataSourceKey = "scp_ip_allowlists"
+
+	schemaKeyFeatures   = "features"
+	schemaKeyAllowlists = "allowlists"
+)
+
+// Features are the components of a stack that access can be restricted for with an IP allowlist
+var Features = []string{
+	string(v2.Feature_hec),
+	string(v2.Feature_idm_api),
+	string(v2.Feature_idm_ui),
+	string(v2.Feature_s2s),
+	string(v2.Feature_search_api),
+	string(v2.Feature_search_ui),
+}
+
+func ipAllowlistDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyFeatures: {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(Features, false)),
+			},
+			Description: "The features to read the IP allowlists of. Defaults to all features.",
+		},
+		schemaKeyAllowlists: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyFeature: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The feature of the IP allowlist.",
+					},
+					schemaKeySubnets: {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Description: "The sorted subnets that have access to the feature.",
+					},
+				},
+			},
+			Description: "The IP allowlist of each feature, sorted by feature.",
+		},
+	}
+}
+
+func DataSourceIPAllowlist() *schema.Resource {
+	return &schema.Resource{
+		Description: "IP Allowlist Data Source. Use this data source to audit the IP allowlists of all features of a stack " +
+			"without managing them with Terraform. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureIPAllowList " +
+			"for more latest, detailed information on the ACS IP Allowlist API.",
+
+		ReadContext: dataSourceIPAllowlistRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: ipAllowlistDataSourceSchema(),
+	}
+}
+
+func dataSourceIPAllowlistRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	features := Features
+	if values, ok := d.GetOk(schemaKeyFeatures); ok {
+		features = utils.ParseSetValues(values)
+		sort.Strings(features)
+	}
+
+	allowlists := make([]interface{}, 0, len(features))
+	for _, feature := range features {
+		subnets, err := WaitIPAllowlistRead(ctx, acsClient, stack, feature)
+		if err != nil {
+			// features that are not available on the stack have no allowlist
+			if errors.IsUnknownFeatureError(err) {
+				tflog.Info(ctx, fmt.Sprintf("Skipping IP allowlist feature (%s) not available on stack: %s.", feature, err))
+				continue
+			}
+			return diag.Errorf("Error reading ip allowlist (%s): %s", feature, err)
+		}
+
+		sort.Strings(subnets)
+		allowlists = append(allowlists, map[string]interface{}{
+			schemaKeyFeature: feature,
+			schemaKeySubnets: subnets,
+		})
+	}
+
+	if err := d.Set(schemaKeyAllowlists, allowlists); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package ipallowlists_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const ipAllowlistDataSourceTemplate = `
+data "scp_ip_allowlists" %[1]q {
+	features = [%[2]q]
+}
+`
+
+func TestAcc_SplunkCloudIPAllowlist_DataSource_basic(t *testing.T) {
+	dataSourceName := "all"
+	feature := "search-api"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(ipAllowlistDataSourceTemplate, dataSourceName, feature),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.scp_ip_allowlists.%s", dataSourceName), "allowlists.#", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.scp_ip_allowlists.%s", dataSourceName), "allowlists.0.feature", feature),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_ip_allowlists.%s", dataSourceName), "allowlists.0.subnets.#"),
+				),
+			},
+		},
+	})
+}
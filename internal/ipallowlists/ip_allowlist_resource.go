@@ -10,6 +10,7 @@ import (
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
 )
 
 const (
@@ -31,8 +32,10 @@ func ipAllowlistResourceSchema() map[string]*schema.Schema {
 			Type:     schema.TypeSet,
 			Required: true,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: utils.ValidateIPv4Subnet,
 			},
+			Set:         utils.HashSubnet,
 			Description: "Subnets is a list of IP addresses that have access to the corresponding feature.",
 			MinItems:    1,
 		},
@@ -53,6 +56,8 @@ func ResourceIPAllowlist() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: utils.CustomizeDiffSubnets(schemaKeySubnets),
+
 		Schema: ipAllowlistResourceSchema(),
 	}
 }
@@ -0,0 +1,166 @@
+package ipallowlists
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	SubnetResourceKey = "scp_ip_allowlist_subnet"
+
+	schemaKeySubnet = "subnet"
+)
+
+func ipAllowlistSubnetResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyFeature: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(Features, false)),
+			Description: "Feature is a specified component in your Splunk Cloud Platform. Eg: search-api, hec, etc. " +
+				"Can not be updated after creation, if changed in config file terraform will propose a replacement.",
+		},
+		schemaKeySubnet: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: utils.ValidateIPv4Subnet,
+			DiffSuppressFunc: utils.SuppressEquivalentSubnet,
+			Description: "Subnet is an IP address range in CIDR notation that has access to the feature. " +
+				"Can not be updated after creation, if changed in config file terraform will propose a replacement.",
+		},
+	}
+}
+
+func ResourceIPAllowlistSubnet() *schema.Resource {
+	return &schema.Resource{
+		Description: "IP Allowlist Subnet Resource. Manages a single subnet of the IP allowlist of a feature without taking " +
+			"ownership of the other subnets of the feature. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureIPAllowList " +
+			"for more latest, detailed information on attribute requirements and the ACS IP Allowlist API.",
+
+		CreateContext: resourceIPAllowlistSubnetCreate,
+		ReadContext:   resourceIPAllowlistSubnetRead,
+		DeleteContext: resourceIPAllowlistSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: ipAllowlistSubnetResourceSchema(),
+	}
+}
+
+func resourceIPAllowlistSubnetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	feature := d.Get(schemaKeyFeature).(string)
+	subnet := d.Get(schemaKeySubnet).(string)
+
+	if err := WaitIPAllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), []string{subnet}); err != nil {
+		return diag.Errorf("Error submitting request for subnet (%s) to be added to ip allowlist (%s): %s", subnet, feature, err)
+	}
+
+	//Poll until the subnet has been added to the allowlist so that the following read does not remove it from state
+	if err := WaitVerifyIPAllowlistSubnetUpdate(ctx, acsClient, stack, feature, subnet, true); err != nil {
+		return diag.Errorf("Error waiting for subnet (%s) to be added to ip allowlist (%s): %s", subnet, feature, err)
+	}
+
+	// Set ID of subnet resource to indicate subnet has been added
+	d.SetId(AllowlistSubnetID(feature, subnet))
+	tflog.Info(ctx, fmt.Sprintf("Added subnet (%s) to IP Allowlist of feature: %s\n", subnet, feature))
+
+	return resourceIPAllowlistSubnetRead(ctx, d, m)
+}
+
+func resourceIPAllowlistSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	feature, subnet, err := ParseAllowlistSubnetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnets, err := WaitIPAllowlistRead(ctx, acsClient, stack, feature)
+	if err != nil {
+		// if feature not found set id of resource to empty string to remove from state
+		if errors.IsUnknownFeatureError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Invalid IP Allowlist feature (%s): %s.", feature, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading ip allowlist (%s): %s", feature, err)
+	}
+
+	// if subnet was removed from the allowlist outside of terraform remove it from state
+	if !utils.ContainsSubnet(subnets, subnet) {
+		tflog.Info(ctx, fmt.Sprintf("Removing subnet (%s) from state. Subnet not found in ip allowlist (%s).", subnet, feature))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(schemaKeyFeature, feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeySubnet, subnet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIPAllowlistSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	feature := d.Get(schemaKeyFeature).(string)
+	subnet := d.Get(schemaKeySubnet).(string)
+
+	if err := WaitIPAllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), []string{subnet}); err != nil {
+		if errors.IsUnknownFeatureError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Invalid IP Allowlist feature (%s): %s.", feature, err))
+			return nil
+		}
+		return diag.Errorf("Error removing subnet (%s) from ip allowlist (%s): %s", subnet, feature, err)
+	}
+
+	//Poll until the subnet has been removed from the allowlist
+	if err := WaitVerifyIPAllowlistSubnetUpdate(ctx, acsClient, stack, feature, subnet, false); err != nil {
+		return diag.Errorf("Error waiting for subnet (%s) to be removed from ip allowlist (%s): %s", subnet, feature, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removed subnet (%s) from IP Allowlist of feature: %s\n", subnet, feature))
+	return nil
+}
+
+// AllowlistSubnetID returns the ID of an allowlist subnet resource in the format <feature>/<subnet>
+func AllowlistSubnetID(feature string, subnet string) string {
+	return fmt.Sprintf("%s/%s", feature, utils.NormalizeSubnet(subnet))
+}
+
+// ParseAllowlistSubnetID returns the feature and subnet of an allowlist subnet resource ID in the format <feature>/<subnet>
+func ParseAllowlistSubnetID(id string) (feature string, subnet string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ip allowlist subnet ID (%s), expected <feature>/<subnet>, e.g. search-api/10.0.0.0/24", id)
+	}
+	return parts[0], parts[1], nil
+}
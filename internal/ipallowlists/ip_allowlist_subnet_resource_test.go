@@ -0,0 +1,60 @@
+package ipallowlists_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/ipallowlists"
+	"github.com/stretchr/testify/assert"
+)
+
+// The subnet is added to a feature that already has an allowlist, so that removing it at the end of the test leaves
+// the feature accessible
+const ipAllowlistSubnetResourceTemplate = `
+resource "scp_ip_allowlist_subnet" %[1]q {
+	feature = "search-api"
+	subnet  = %[2]q
+}
+`
+
+func TestAcc_SplunkCloudIPAllowlistSubnet(t *testing.T) {
+	resourceName := resource.UniqueId()
+	subnet := "198.51.100.0/24"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(ipAllowlistSubnetResourceTemplate, resourceName, subnet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("scp_ip_allowlist_subnet.%s", resourceName), "subnet", subnet),
+					resource.TestCheckResourceAttr(fmt.Sprintf("scp_ip_allowlist_subnet.%s", resourceName), "id", "search-api/"+subnet),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("scp_ip_allowlist_subnet.%s", resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Test_ParseAllowlistSubnetID(t *testing.T) {
+	t.Run("with valid id", func(t *testing.T) {
+		feature, subnet, err := ipallowlists.ParseAllowlistSubnetID(ipallowlists.AllowlistSubnetID("search-api", "10.0.0.0/24"))
+		assert.NoError(t, err)
+		assert.Equal(t, "search-api", feature)
+		assert.Equal(t, "10.0.0.0/24", subnet)
+	})
+
+	for _, id := range []string{"search-api", "search-api/", "/10.0.0.0/24", ""} {
+		t.Run(fmt.Sprintf("with invalid id %q", id), func(t *testing.T) {
+			_, _, err := ipallowlists.ParseAllowlistSubnetID(id)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
 )
 
 type allowlistResponse struct {
@@ -72,6 +73,26 @@ func IPAllowlistStatusDelete(ctx context.Context, acsClient v2.ClientInterface,
 	}
 }
 
+// IPAllowlistSubnetStatusVerify returns StateRefreshFunc that makes GET request and checks if the subnet has been
+// added to (present) or removed from (not present) the ip allowlist of the feature
+func IPAllowlistSubnetStatusVerify(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, feature string, subnet string, present bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := IPAllowlistStatusRead(ctx, acsClient, stack, feature)()
+		if statusText == http.StatusText(http.StatusTooManyRequests) {
+			return nil, statusText, nil
+		}
+		if err != nil {
+			return nil, statusText, err
+		}
+
+		subnets := output.([]string)
+		if utils.ContainsSubnet(subnets, subnet) == present {
+			return subnets, status.UpdatedStatus, nil
+		}
+		return subnets, statusText, nil
+	}
+}
+
 func ProcessResponse(resp *http.Response, targetStateCodes []string, pendingStatusCodes []string) (interface{}, string, error) {
 	if resp == nil {
 		return nil, "", &resource.UnexpectedStateError{LastError: errors.New("nil response")}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
 )
 
 const (
@@ -23,6 +24,9 @@ var (
 	TargetStatusResourceChange  = []string{http.StatusText(200)}
 	TargetStatusResourceExists  = []string{http.StatusText(200)}
 	TargetStatusResourceDeleted = []string{http.StatusText(404)}
+
+	// Allowlists are read back until the subnet change has been applied
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
 )
 
 // WaitIPAllowlistCreate Handles retry logic for POST requests for create lifecycle function
@@ -96,3 +100,22 @@ func WaitIPAllowlistDelete(ctx context.Context, acsClient v2.ClientInterface, st
 
 	return nil
 }
+
+// WaitVerifyIPAllowlistSubnetUpdate Handles retry logic for polling after POST and DELETE requests until the subnet has
+// been added to (present) or removed from (not present) the ip allowlist of the feature
+func WaitVerifyIPAllowlistSubnetUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, feature string, subnet string, present bool) error {
+	waitIPAllowlistSubnetUpdated := &resource.StateChangeConf{
+		Pending:      PendingStatusVerifyUpdated,
+		Target:       []string{status.UpdatedStatus},
+		Refresh:      IPAllowlistSubnetStatusVerify(ctx, acsClient, stack, feature, subnet, present),
+		Timeout:      Timeout,
+		Delay:        CrudDelayTime,
+		PollInterval: PollInterval,
+	}
+
+	if _, err := waitIPAllowlistSubnetUpdated.WaitForStateContext(ctx); err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming subnet (%s) of ip allowlist (%s) has been updated: %s", subnet, feature, err))
+		return err
+	}
+	return nil
+}
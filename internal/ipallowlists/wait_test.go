@@ -127,3 +127,35 @@ func Test_WaitIPAllowlistDelete(t *testing.T) {
 		}
 	})
 }
+
+func Test_WaitVerifyIPAllowlistSubnetUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(nil, errors.New("some error")).Once()
+		err := ipallowlists.WaitVerifyIPAllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, mockSubnets[0], true)
+		assert.Error(t, err)
+	})
+
+	t.Run("with subnet added", func(t *testing.T) {
+		client.On("DescribeAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(200), nil).Once()
+		err := ipallowlists.WaitVerifyIPAllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, mockSubnets[0], true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with subnet removed", func(t *testing.T) {
+		client.On("DescribeAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(200), nil).Once()
+		err := ipallowlists.WaitVerifyIPAllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, "9.9.9.9/32", false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected response %v", statusCode), func(t *testing.T) {
+				client.On("DescribeAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(statusCode), nil).Once()
+				err := ipallowlists.WaitVerifyIPAllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, mockSubnets[0], true)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -32,8 +32,10 @@ func ipv6AllowlistResourceSchema() map[string]*schema.Schema {
 			Type:     schema.TypeSet,
 			Required: true,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: utils.ValidateIPv6Subnet,
 			},
+			Set:         utils.HashSubnet,
 			Description: "Subnets is a list of IPv6 addresses that have access to the corresponding feature.",
 			MinItems:    1,
 		},
@@ -54,6 +56,8 @@ func ResourceIPv6Allowlist() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: utils.CustomizeDiffSubnets(schemaKeySubnets),
+
 		Schema: ipv6AllowlistResourceSchema(),
 	}
 }
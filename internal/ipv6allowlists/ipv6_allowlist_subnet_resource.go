@@ -0,0 +1,166 @@
+package ipv6allowlists
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	SubnetResourceKey = "scp_ipv6_allowlist_subnet"
+
+	schemaKeySubnet = "subnet"
+)
+
+func ipv6AllowlistSubnetResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyFeature: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(Features, false)),
+			Description: "Feature is a specified component in your Splunk Cloud Platform. Eg: search-api, hec, etc. " +
+				"Can not be updated after creation, if changed in config file terraform will propose a replacement.",
+		},
+		schemaKeySubnet: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: utils.ValidateIPv6Subnet,
+			DiffSuppressFunc: utils.SuppressEquivalentSubnet,
+			Description: "Subnet is an IPv6 address range in CIDR notation that has access to the feature. " +
+				"Can not be updated after creation, if changed in config file terraform will propose a replacement.",
+		},
+	}
+}
+
+func ResourceIPv6AllowlistSubnet() *schema.Resource {
+	return &schema.Resource{
+		Description: "IPv6 Allowlist Subnet Resource. Manages a single subnet of the IPv6 allowlist of a feature without taking " +
+			"ownership of the other subnets of the feature. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureIPAllowList " +
+			"for more latest, detailed information on attribute requirements and the ACS IP Allowlist API.",
+
+		CreateContext: resourceIPv6AllowlistSubnetCreate,
+		ReadContext:   resourceIPv6AllowlistSubnetRead,
+		DeleteContext: resourceIPv6AllowlistSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: ipv6AllowlistSubnetResourceSchema(),
+	}
+}
+
+func resourceIPv6AllowlistSubnetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	feature := d.Get(schemaKeyFeature).(string)
+	subnet := d.Get(schemaKeySubnet).(string)
+
+	if err := WaitIPv6AllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), []string{subnet}); err != nil {
+		return diag.Errorf("Error submitting request for subnet (%s) to be added to ipv6 allowlist (%s): %s", subnet, feature, err)
+	}
+
+	//Poll until the subnet has been added to the allowlist so that the following read does not remove it from state
+	if err := WaitVerifyIPv6AllowlistSubnetUpdate(ctx, acsClient, stack, feature, subnet, true); err != nil {
+		return diag.Errorf("Error waiting for subnet (%s) to be added to ipv6 allowlist (%s): %s", subnet, feature, err)
+	}
+
+	// Set ID of subnet resource to indicate subnet has been added
+	d.SetId(AllowlistSubnetID(feature, subnet))
+	tflog.Info(ctx, fmt.Sprintf("Added subnet (%s) to IPv6 Allowlist of feature: %s\n", subnet, feature))
+
+	return resourceIPv6AllowlistSubnetRead(ctx, d, m)
+}
+
+func resourceIPv6AllowlistSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	feature, subnet, err := ParseAllowlistSubnetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnets, err := WaitIPv6AllowlistRead(ctx, acsClient, stack, feature)
+	if err != nil {
+		// if feature not found set id of resource to empty string to remove from state
+		if errors.IsUnknownFeatureError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Invalid IPv6 Allowlist feature (%s): %s.", feature, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading ipv6 allowlist (%s): %s", feature, err)
+	}
+
+	// if subnet was removed from the allowlist outside of terraform remove it from state
+	if !utils.ContainsSubnet(subnets, subnet) {
+		tflog.Info(ctx, fmt.Sprintf("Removing subnet (%s) from state. Subnet not found in ipv6 allowlist (%s).", subnet, feature))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(schemaKeyFeature, feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeySubnet, subnet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIPv6AllowlistSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	feature := d.Get(schemaKeyFeature).(string)
+	subnet := d.Get(schemaKeySubnet).(string)
+
+	if err := WaitIPv6AllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), []string{subnet}); err != nil {
+		if errors.IsUnknownFeatureError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Invalid IPv6 Allowlist feature (%s): %s.", feature, err))
+			return nil
+		}
+		return diag.Errorf("Error removing subnet (%s) from ipv6 allowlist (%s): %s", subnet, feature, err)
+	}
+
+	//Poll until the subnet has been removed from the allowlist
+	if err := WaitVerifyIPv6AllowlistSubnetUpdate(ctx, acsClient, stack, feature, subnet, false); err != nil {
+		return diag.Errorf("Error waiting for subnet (%s) to be removed from ipv6 allowlist (%s): %s", subnet, feature, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removed subnet (%s) from IPv6 Allowlist of feature: %s\n", subnet, feature))
+	return nil
+}
+
+// AllowlistSubnetID returns the ID of an allowlist subnet resource in the format <feature>/<subnet>
+func AllowlistSubnetID(feature string, subnet string) string {
+	return fmt.Sprintf("%s/%s", feature, utils.NormalizeSubnet(subnet))
+}
+
+// ParseAllowlistSubnetID returns the feature and subnet of an allowlist subnet resource ID in the format <feature>/<subnet>
+func ParseAllowlistSubnetID(id string) (feature string, subnet string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ipv6 allowlist subnet ID (%s), expected <feature>/<subnet>, e.g. search-api/2001:db8::/32", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,64 @@
+package ipv6allowlists_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/ipv6allowlists"
+	"github.com/stretchr/testify/assert"
+)
+
+// The subnet is added to a feature that already has an allowlist, so that removing it at the end of the test leaves
+// the feature accessible
+const ipv6AllowlistSubnetResourceTemplate = `
+resource "scp_ipv6_allowlist_subnet" %[1]q {
+	feature = "search-api"
+	subnet  = %[2]q
+}
+`
+
+func TestAcc_SplunkCloudIPv6AllowlistSubnet(t *testing.T) {
+	resourceName := resource.UniqueId()
+	subnet := "2001:db8:100::/48"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(ipv6AllowlistSubnetResourceTemplate, resourceName, subnet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("scp_ipv6_allowlist_subnet.%s", resourceName), "subnet", subnet),
+					resource.TestCheckResourceAttr(fmt.Sprintf("scp_ipv6_allowlist_subnet.%s", resourceName), "id", "search-api/"+subnet),
+				),
+			},
+			{
+				ResourceName:      fmt.Sprintf("scp_ipv6_allowlist_subnet.%s", resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Test_ParseAllowlistSubnetID(t *testing.T) {
+	t.Run("with valid id", func(t *testing.T) {
+		feature, subnet, err := ipv6allowlists.ParseAllowlistSubnetID(ipv6allowlists.AllowlistSubnetID("search-api", "2001:db8::/32"))
+		assert.NoError(t, err)
+		assert.Equal(t, "search-api", feature)
+		assert.Equal(t, "2001:db8::/32", subnet)
+	})
+
+	t.Run("with subnet in non canonical notation", func(t *testing.T) {
+		assert.Equal(t, "search-api/2001:db8::/32", ipv6allowlists.AllowlistSubnetID("search-api", "2001:DB8:0::/32"))
+	})
+
+	for _, id := range []string{"search-api", "search-api/", "/2001:db8::/32", ""} {
+		t.Run(fmt.Sprintf("with invalid id %q", id), func(t *testing.T) {
+			_, _, err := ipv6allowlists.ParseAllowlistSubnetID(id)
+			assert.Error(t, err)
+		})
+	}
+}
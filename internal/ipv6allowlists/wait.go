@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
 )
 
 const (
@@ -22,6 +23,9 @@ var (
 
 	TargetStatusResourceChange = []string{http.StatusText(200)}
 	TargetStatusResourceExists = []string{http.StatusText(200)}
+
+	// Allowlists are read back until the subnet change has been applied
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
 )
 
 // WaitIPAllowlistCreate Handles retry logic for POST requests for create lifecycle function
@@ -95,3 +99,22 @@ func WaitIPv6AllowlistDelete(ctx context.Context, acsClient v2.ClientInterface,
 
 	return nil
 }
+
+// WaitVerifyIPv6AllowlistSubnetUpdate Handles retry logic for polling after POST and DELETE requests until the subnet has
+// been added to (present) or removed from (not present) the ipv6 allowlist of the feature
+func WaitVerifyIPv6AllowlistSubnetUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, feature string, subnet string, present bool) error {
+	waitIPv6AllowlistSubnetUpdated := &resource.StateChangeConf{
+		Pending:      PendingStatusVerifyUpdated,
+		Target:       []string{status.UpdatedStatus},
+		Refresh:      IPv6AllowlistSubnetStatusVerify(ctx, acsClient, stack, feature, subnet, present),
+		Timeout:      Timeout,
+		Delay:        CrudDelayTime,
+		PollInterval: PollInterval,
+	}
+
+	if _, err := waitIPv6AllowlistSubnetUpdated.WaitForStateContext(ctx); err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming subnet (%s) of ipv6 allowlist (%s) has been updated: %s", subnet, feature, err))
+		return err
+	}
+	return nil
+}
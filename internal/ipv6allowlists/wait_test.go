@@ -27,25 +27,25 @@ var (
 func Test_WaitIPv6AllowlistCreate(t *testing.T) {
 	client := &mocks.ClientInterface{}
 
-	mockCreateBody := v2.AddSubnetsJSONRequestBody{
+	mockCreateBody := v2.CreateAllowlistV6JSONRequestBody{
 		Subnets: &mockSubnets,
 	}
 
 	t.Run("with some client interface error", func(_ *testing.T) {
-		client.On("CreateAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(nil, errors.New("some error")).Once()
+		client.On("CreateAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(nil, errors.New("some error")).Once()
 		err := ipv6allowlists.WaitIPv6AllowlistCreate(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 		assert.Error(t, err)
 	})
 
 	t.Run("with http response 200", func(t *testing.T) {
-		client.On("CreateAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(successRespOk, nil).Once()
+		client.On("CreateAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(successRespOk, nil).Once()
 		err := ipv6allowlists.WaitIPv6AllowlistCreate(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 		assert.NoError(t, err)
 	})
 
 	t.Run("with retryable response 429", func(t *testing.T) {
-		client.On("CreateAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(rateLimitResp, nil).Once()
-		client.On("CreateAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(successRespOk, nil).Once()
+		client.On("CreateAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(rateLimitResp, nil).Once()
+		client.On("CreateAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(successRespOk, nil).Once()
 		err := ipv6allowlists.WaitIPv6AllowlistCreate(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 		assert.NoError(t, err)
 	})
@@ -53,7 +53,7 @@ func Test_WaitIPv6AllowlistCreate(t *testing.T) {
 	t.Run("with unexpected http responses", func(t *testing.T) {
 		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
 			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
-				client.On("CreateAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(getIPAllowlistResponse(statusCode), nil).Once()
+				client.On("CreateAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockCreateBody).Return(getIPAllowlistResponse(statusCode), nil).Once()
 				err := ipv6allowlists.WaitIPv6AllowlistCreate(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 				assert.Error(t, err)
 			})
@@ -65,14 +65,14 @@ func Test_WaitIPAllowlistRead(t *testing.T) {
 	client := &mocks.ClientInterface{}
 
 	t.Run("with some client interface error", func(t *testing.T) {
-		client.On("ReadAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(nil, errors.New("some error")).Once()
+		client.On("DescribeAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(nil, errors.New("some error")).Once()
 		subnets, err := ipv6allowlists.WaitIPv6AllowlistRead(context.TODO(), client, v2.Stack(mockStack), mockFeature)
 		assert.Error(t, err)
 		assert.Nil(t, subnets)
 	})
 
 	t.Run("with http 200 response", func(t *testing.T) {
-		client.On("ReadAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(200), nil).Once()
+		client.On("DescribeAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(200), nil).Once()
 		subnets, err := ipv6allowlists.WaitIPv6AllowlistRead(context.TODO(), client, v2.Stack(mockStack), mockFeature)
 		assert.NoError(t, err)
 		assert.NotNil(t, subnets)
@@ -82,7 +82,7 @@ func Test_WaitIPAllowlistRead(t *testing.T) {
 	t.Run("with unexpected response", func(t *testing.T) {
 		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
 			t.Run(fmt.Sprintf("with unexpected response %v", statusCode), func(t *testing.T) {
-				client.On("ReadAllowlist", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(statusCode), nil).Once()
+				client.On("DescribeAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(statusCode), nil).Once()
 				subnets, err := ipv6allowlists.WaitIPv6AllowlistRead(context.TODO(), client, v2.Stack(mockStack), mockFeature)
 				assert.Error(t, err)
 				assert.Nil(t, subnets)
@@ -94,25 +94,25 @@ func Test_WaitIPAllowlistRead(t *testing.T) {
 func Test_WaitIPAllowlistDelete(t *testing.T) {
 	client := &mocks.ClientInterface{}
 
-	mockDeleteBody := v2.DeleteSubnetsJSONRequestBody{
+	mockDeleteBody := v2.DeleteAllowlistsV6JSONRequestBody{
 		Subnets: &mockSubnets,
 	}
 
 	t.Run("with some client interface error", func(_ *testing.T) {
-		client.On("DeleteSubnets", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(nil, errors.New("some error")).Once()
+		client.On("DeleteAllowlistsV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(nil, errors.New("some error")).Once()
 		err := ipv6allowlists.WaitIPv6AllowlistDelete(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 		assert.Error(t, err)
 	})
 
 	t.Run("with http response 200", func(t *testing.T) {
-		client.On("DeleteSubnets", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(successRespOk, nil).Once()
+		client.On("DeleteAllowlistsV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(successRespOk, nil).Once()
 		err := ipv6allowlists.WaitIPv6AllowlistDelete(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 		assert.NoError(t, err)
 	})
 
 	t.Run("with retryable response 429", func(t *testing.T) {
-		client.On("DeleteSubnets", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(rateLimitResp, nil).Once()
-		client.On("DeleteSubnets", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(successRespOk, nil).Once()
+		client.On("DeleteAllowlistsV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(rateLimitResp, nil).Once()
+		client.On("DeleteAllowlistsV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(successRespOk, nil).Once()
 		err := ipv6allowlists.WaitIPv6AllowlistDelete(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 		assert.NoError(t, err)
 	})
@@ -120,10 +120,42 @@ func Test_WaitIPAllowlistDelete(t *testing.T) {
 	t.Run("with unexpected http responses", func(t *testing.T) {
 		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
 			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
-				client.On("DeleteSubnets", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(getIPAllowlistResponse(statusCode), nil).Once()
+				client.On("DeleteAllowlistsV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature), mockDeleteBody).Return(getIPAllowlistResponse(statusCode), nil).Once()
 				err := ipv6allowlists.WaitIPv6AllowlistDelete(context.TODO(), client, v2.Stack(mockStack), v2.Feature(mockFeature), mockSubnets)
 				assert.Error(t, err)
 			})
 		}
 	})
 }
+
+func Test_WaitVerifyIPv6AllowlistSubnetUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(nil, errors.New("some error")).Once()
+		err := ipv6allowlists.WaitVerifyIPv6AllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, mockSubnets[0], true)
+		assert.Error(t, err)
+	})
+
+	t.Run("with subnet added", func(t *testing.T) {
+		client.On("DescribeAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(200), nil).Once()
+		err := ipv6allowlists.WaitVerifyIPv6AllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, mockSubnets[0], true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with subnet removed", func(t *testing.T) {
+		client.On("DescribeAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(200), nil).Once()
+		err := ipv6allowlists.WaitVerifyIPv6AllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, "2001:db8:9::/64", false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected response %v", statusCode), func(t *testing.T) {
+				client.On("DescribeAllowlistV6", mock.Anything, v2.Stack(mockStack), v2.Feature(mockFeature)).Return(getIPAllowlistResponse(statusCode), nil).Once()
+				err := ipv6allowlists.WaitVerifyIPv6AllowlistSubnetUpdate(context.TODO(), client, v2.Stack(mockStack), mockFeature, mockSubnets[0], true)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
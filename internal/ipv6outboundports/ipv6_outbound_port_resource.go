@@ -0,0 +1,29 @@
+package ipv6outboundports
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	ResourceKey = "scp_ipv6_outbound_ports"
+)
+
+// ResourceIPv6OutboundPort shares the lifecycle of the IPv4 outbound port resource and makes its requests against the V6 outbound endpoints
+func ResourceIPv6OutboundPort() *schema.Resource {
+	return outboundports.NewOutboundPortResource(outboundports.OutboundPortResourceConfig{
+		Name: "IPv6 outbound port",
+		Description: "IPv6 Outbound Ports Resource. Please see documentation to understand unique behavior regarding naming and delete operation. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureOutboundPorts " +
+			"for more latest, detailed information on attribute requirements and the ACS IPv6 Outbound Ports API.",
+		SubnetDescription: "Subnets is a list of destination IPv6 subnets the stack is allowed to reach on the port.",
+		ValidateSubnet:    utils.ValidateIPv6Subnet,
+
+		List:         WaitIPv6OutboundPortList,
+		Read:         WaitIPv6OutboundPortRead,
+		Create:       WaitIPv6OutboundPortCreate,
+		Delete:       WaitIPv6OutboundPortDelete,
+		VerifyUpdate: WaitVerifyIPv6OutboundPortUpdate,
+	})
+}
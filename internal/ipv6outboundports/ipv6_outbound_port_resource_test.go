@@ -0,0 +1,62 @@
+package ipv6outboundports_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+var (
+	outboundPort          = 8089
+	outboundSubnets       = []string{"2001:db8::/64"}
+	outboundSubnetsUpdate = []string{"2001:db8::/64", "2001:db8:0:1::/64"}
+)
+
+func resourcePrefix(resourceName string) string {
+	return fmt.Sprint("scp_ipv6_outbound_ports.", resourceName)
+}
+
+func TestAcc_SplunkCloudIPv6OutboundPort(t *testing.T) {
+	resourceName := resource.UniqueId()
+
+	outboundPortResourceTest := []resource.TestStep{
+		// Open IPv6 outbound port
+		{
+			Config: testAccInstanceConfigIPv6OutboundPort(resourceName, outboundSubnets),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "port", fmt.Sprint(outboundPort)),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "subnets.#", fmt.Sprint(len(outboundSubnets))),
+			),
+		},
+		// Add a subnet to the IPv6 outbound port
+		{
+			Config: testAccInstanceConfigIPv6OutboundPort(resourceName, outboundSubnetsUpdate),
+			Check:  resource.TestCheckResourceAttr(resourcePrefix(resourceName), "subnets.#", fmt.Sprint(len(outboundSubnetsUpdate))),
+		},
+		// Import IPv6 outbound port
+		{
+			ResourceName:            resourcePrefix(resourceName),
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"reason"},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps:             outboundPortResourceTest,
+	})
+}
+
+func testAccInstanceConfigIPv6OutboundPort(resourceName string, subnets []string) string {
+	subnetList, _ := json.Marshal(subnets)
+	return fmt.Sprintf(`resource "scp_ipv6_outbound_ports" %[1]q {
+		port    = %[2]d
+		subnets = %[3]s
+		reason  = "terraform acceptance test"
+	}`, resourceName, outboundPort, subnetList)
+}
@@ -0,0 +1,183 @@
+package ipv6outboundports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// IPv6OutboundPortStatusCreate returns StateRefreshFunc that makes POST request to add subnets to an IPv6 outbound port and checks if request was accepted
+func IPv6OutboundPortStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, reason string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		createBody := v2.CreateOutboundPortsV6JSONRequestBody{
+			OutboundPorts: &[]struct {
+				Port    *int32    `json:"port,omitempty"`
+				Subnets *[]string `json:"subnets,omitempty"`
+			}{{Port: &port, Subnets: &subnets}},
+		}
+		if reason != "" {
+			createBody.Reason = &reason
+		}
+		resp, err := acsClient.CreateOutboundPortsV6(ctx, stack, createBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// IPv6OutboundPortStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the subnets of the IPv6 outbound port
+func IPv6OutboundPortStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundportsV6(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		subnets := make([]string, 0)
+		if resp.StatusCode == http.StatusOK {
+			if subnets, err = parseIPv6OutboundPortSubnets(bodyBytes, port); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return subnets, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// IPv6OutboundPortStatusList returns StateRefreshFunc that makes GET request, checks if request was successful, and returns all IPv6 outbound ports
+func IPv6OutboundPortStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListOutboundPortsV6(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		outboundPorts := make([]v2.OutboundResponse, 0)
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &outboundPorts); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return outboundPorts, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// IPv6OutboundPortStatusDelete returns StateRefreshFunc that makes DELETE request to remove subnets from an IPv6 outbound port and checks if request was accepted
+func IPv6OutboundPortStatusDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deleteBody := v2.DeleteOutboundPortV6JSONRequestBody{
+			Subnets: &subnets,
+		}
+		resp, err := acsClient.DeleteOutboundPortV6(ctx, stack, port, deleteBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// IPv6OutboundPortStatusVerify returns StateRefreshFunc that makes GET request and checks if the given subnets have been
+// added to (present) or removed from (not present) the IPv6 outbound port
+func IPv6OutboundPortStatusVerify(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, present bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundportsV6(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; ok {
+			return nil, http.StatusText(resp.StatusCode), nil
+		}
+
+		currentSubnets := make([]string, 0)
+		switch resp.StatusCode {
+		case http.StatusOK:
+			if currentSubnets, err = parseIPv6OutboundPortSubnets(bodyBytes, port); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		case http.StatusNotFound:
+			// the port is removed once its last subnet has been deleted
+		default:
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: []string{status.UpdatedStatus},
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		if VerifySubnets(subnets, currentSubnets, present) {
+			return currentSubnets, status.UpdatedStatus, nil
+		}
+		return currentSubnets, http.StatusText(http.StatusOK), nil
+	}
+}
+
+// VerifySubnets is a helper to verify that all subnets are either present or absent in the current subnets,
+// subnets are compared in their canonical form
+func VerifySubnets(subnets []string, currentSubnets []string, present bool) bool {
+	current := map[string]bool{}
+	for _, subnet := range currentSubnets {
+		current[utils.NormalizeSubnet(subnet)] = true
+	}
+	for _, subnet := range subnets {
+		if current[utils.NormalizeSubnet(subnet)] != present {
+			return false
+		}
+	}
+	return true
+}
+
+// parseIPv6OutboundPortSubnets returns the destination subnets of the given port from a describe IPv6 outbound port response
+func parseIPv6OutboundPortSubnets(bodyBytes []byte, port int32) ([]string, error) {
+	var outboundPorts []v2.OutboundResponse
+	if err := json.Unmarshal(bodyBytes, &outboundPorts); err != nil {
+		return nil, err
+	}
+
+	subnets := make([]string, 0)
+	for _, outboundPort := range outboundPorts {
+		if outboundPort.Port != nil && *outboundPort.Port != port {
+			continue
+		}
+		if outboundPort.DestinationRanges != nil {
+			subnets = append(subnets, *outboundPort.DestinationRanges...)
+		}
+	}
+	return subnets, nil
+}
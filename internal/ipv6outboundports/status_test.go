@@ -0,0 +1,83 @@
+package ipv6outboundports_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/ipv6outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_IPv6OutboundPortStatusVerify(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with subnets not yet added", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets[:1]), nil).Once()
+		_, state, err := ipv6outboundports.IPv6OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets, true)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), state)
+	})
+
+	t.Run("with subnets added", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		_, state, err := ipv6outboundports.IPv6OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets, true)()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, state)
+	})
+
+	t.Run("with subnets not yet removed", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		_, state, err := ipv6outboundports.IPv6OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets[:1], false)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), state)
+	})
+
+	t.Run("with port not found after removing subnets", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusNotFound, nil), nil).Once()
+		_, state, err := ipv6outboundports.IPv6OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets, false)()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, state)
+	})
+}
+
+func Test_VerifySubnets(t *testing.T) {
+	assert.True(t, ipv6outboundports.VerifySubnets(mockSubnets, mockSubnets, true))
+	assert.False(t, ipv6outboundports.VerifySubnets(mockSubnets, mockSubnets[:1], true))
+	assert.True(t, ipv6outboundports.VerifySubnets(mockSubnets[1:], mockSubnets[:1], false))
+	assert.False(t, ipv6outboundports.VerifySubnets(mockSubnets, mockSubnets[:1], false))
+	assert.True(t, ipv6outboundports.VerifySubnets(mockSubnets, nil, false))
+	assert.True(t, ipv6outboundports.VerifySubnets([]string{"2001:DB8::1/128"}, mockSubnets, true))
+}
+
+func genIPv6OutboundPortResp(statusCode int, subnets []string) *http.Response {
+	if statusCode != http.StatusOK {
+		return genIPv6OutboundPortErrResp(statusCode, http.StatusText(statusCode))
+	}
+
+	port := mockPort
+	destinationRanges := subnets
+	b, _ := json.Marshal([]v2.OutboundResponse{{Port: &port, DestinationRanges: &destinationRanges}})
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader(b)),
+	}
+}
+
+func genIPv6OutboundPortErrResp(statusCode int, message string) *http.Response {
+	b, _ := json.Marshal(&v2.Error{
+		Code:    message,
+		Message: message,
+	})
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader(b)),
+	}
+}
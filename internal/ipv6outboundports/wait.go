@@ -0,0 +1,102 @@
+package ipv6outboundports
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// Outbound port changes are either processed synchronously (200) or accepted as a deployment task (202)
+	TargetStatusResourceChange = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusAccepted)}
+
+	// Outbound ports are read back until the deployment task has been applied
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitIPv6OutboundPortCreate Handles retry logic for POST requests adding subnets to an IPv6 outbound port for the create and update lifecycle functions
+func WaitIPv6OutboundPortCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, reason string) error {
+	waitIPv6OutboundPortCreateAccepted := wait.GenerateWriteStateChangeConf(IPv6OutboundPortStatusCreate(ctx, acsClient, stack, port, subnets, reason))
+	waitIPv6OutboundPortCreateAccepted.Target = TargetStatusResourceChange
+
+	rawResp, err := waitIPv6OutboundPortCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for IPv6 outbound port (%d) to be created: %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and creation in progress
+	tflog.Info(ctx, fmt.Sprintf("Create response status code for IPv6 outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for IPv6 outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitIPv6OutboundPortRead Handles retry logic for GET requests for the read lifecycle function
+func WaitIPv6OutboundPortRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) ([]string, error) {
+	waitIPv6OutboundPortRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, IPv6OutboundPortStatusRead(ctx, acsClient, stack, port))
+
+	output, err := waitIPv6OutboundPortRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading IPv6 outbound port (%d): %s", port, err))
+		return nil, err
+	}
+	subnets := output.([]string)
+
+	return subnets, nil
+}
+
+// WaitIPv6OutboundPortList Handles retry logic for GET requests listing all IPv6 outbound ports
+func WaitIPv6OutboundPortList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.OutboundResponse, error) {
+	waitIPv6OutboundPortList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, IPv6OutboundPortStatusList(ctx, acsClient, stack))
+
+	output, err := waitIPv6OutboundPortList.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error listing IPv6 outbound ports: %s", err))
+		return nil, err
+	}
+	outboundPorts := output.([]v2.OutboundResponse)
+
+	return outboundPorts, nil
+}
+
+// WaitIPv6OutboundPortDelete Handles retry logic for DELETE requests removing subnets from an IPv6 outbound port for the update and delete lifecycle functions
+func WaitIPv6OutboundPortDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string) error {
+	waitIPv6OutboundPortDeleteAccepted := wait.GenerateWriteStateChangeConf(IPv6OutboundPortStatusDelete(ctx, acsClient, stack, port, subnets))
+	waitIPv6OutboundPortDeleteAccepted.Target = TargetStatusResourceChange
+
+	rawResp, err := waitIPv6OutboundPortDeleteAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for IPv6 outbound port (%d) to be deleted: %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and deletion in progress
+	tflog.Info(ctx, fmt.Sprintf("Delete response status code for IPv6 outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for IPv6 outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyIPv6OutboundPortUpdate Handles retry logic for polling after POST and DELETE requests until the subnets have been
+// added to (present) or removed from (not present) the IPv6 outbound port
+func WaitVerifyIPv6OutboundPortUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, present bool) error {
+	waitIPv6OutboundPortUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, IPv6OutboundPortStatusVerify(ctx, acsClient, stack, port, subnets, present))
+
+	_, err := waitIPv6OutboundPortUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming IPv6 outbound port (%d) has been updated: %s", port, err))
+		return err
+	}
+
+	return nil
+}
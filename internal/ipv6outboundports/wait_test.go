@@ -0,0 +1,208 @@
+package ipv6outboundports_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/ipv6outboundports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack  = "mock-stack"
+	mockPort   = int32(8089)
+	mockReason = "mock-reason"
+)
+
+var (
+	unexpectedStatusCodes     = []int{400, 401, 403, 404, 409, 501, 503}
+	unexpectedStatusCodesPoll = []int{400, 401, 403, 409, 501, 500, 503}
+
+	mockSubnets = []string{"2001:db8::1/128", "2001:db8::2/128"}
+)
+
+func mockCreateBody(subnets []string) v2.CreateOutboundPortsV6JSONRequestBody {
+	port := mockPort
+	reason := mockReason
+	return v2.CreateOutboundPortsV6JSONRequestBody{
+		OutboundPorts: &[]struct {
+			Port    *int32    `json:"port,omitempty"`
+			Subnets *[]string `json:"subnets,omitempty"`
+		}{{Port: &port, Subnets: &subnets}},
+		Reason: &reason,
+	}
+}
+
+func Test_WaitIPv6OutboundPortCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	createBody := mockCreateBody(mockSubnets)
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), createBody).Return(nil, errors.New("some error")).Once()
+		err := ipv6outboundports.WaitIPv6OutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), createBody).Return(genIPv6OutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := ipv6outboundports.WaitIPv6OutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), createBody).Return(genIPv6OutboundPortResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), createBody).Return(genIPv6OutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := ipv6outboundports.WaitIPv6OutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), createBody).Return(genIPv6OutboundPortResp(statusCode, nil), nil).Once()
+				err := ipv6outboundports.WaitIPv6OutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitIPv6OutboundPortRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(nil, errors.New("some error")).Once()
+		subnets, err := ipv6outboundports.WaitIPv6OutboundPortRead(context.TODO(), client, mockStack, mockPort)
+		assert.Error(t, err)
+		assert.Nil(t, subnets)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		subnets, err := ipv6outboundports.WaitIPv6OutboundPortRead(context.TODO(), client, mockStack, mockPort)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, mockSubnets, subnets)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		subnets, err := ipv6outboundports.WaitIPv6OutboundPortRead(context.TODO(), client, mockStack, mockPort)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, mockSubnets, subnets)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(statusCode, nil), nil).Once()
+				subnets, err := ipv6outboundports.WaitIPv6OutboundPortRead(context.TODO(), client, mockStack, mockPort)
+				assert.Error(t, err)
+				assert.Nil(t, subnets)
+			})
+		}
+	})
+}
+
+func Test_WaitIPv6OutboundPortList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ListOutboundPortsV6", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		outboundPorts, err := ipv6outboundports.WaitIPv6OutboundPortList(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, outboundPorts)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("ListOutboundPortsV6", mock.Anything, v2.Stack(mockStack)).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		outboundPorts, err := ipv6outboundports.WaitIPv6OutboundPortList(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Len(t, outboundPorts, 1)
+		assert.Equal(t, mockPort, *outboundPorts[0].Port)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ListOutboundPortsV6", mock.Anything, v2.Stack(mockStack)).Return(genIPv6OutboundPortResp(statusCode, nil), nil).Once()
+				outboundPorts, err := ipv6outboundports.WaitIPv6OutboundPortList(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, outboundPorts)
+			})
+		}
+	})
+}
+
+func Test_WaitIPv6OutboundPortDelete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	deleteBody := v2.DeleteOutboundPortV6JSONRequestBody{Subnets: &mockSubnets}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(nil, errors.New("some error")).Once()
+		err := ipv6outboundports.WaitIPv6OutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genIPv6OutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := ipv6outboundports.WaitIPv6OutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with dependency incomplete response 424", func(t *testing.T) {
+		client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genIPv6OutboundPortErrResp(http.StatusFailedDependency, "424-dependency-incomplete"), nil).Once()
+		client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genIPv6OutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := ipv6outboundports.WaitIPv6OutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with failed dependency response 424", func(t *testing.T) {
+		client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genIPv6OutboundPortErrResp(http.StatusFailedDependency, "424-failed-dependency"), nil).Once()
+		err := ipv6outboundports.WaitIPv6OutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.Error(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genIPv6OutboundPortResp(statusCode, nil), nil).Once()
+				err := ipv6outboundports.WaitIPv6OutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyIPv6OutboundPortUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with subnets added after pending update", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets[:1]), nil).Once()
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		err := ipv6outboundports.WaitVerifyIPv6OutboundPortUpdate(context.TODO(), client, mockStack, mockPort, mockSubnets, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with subnets removed and port not found", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(http.StatusNotFound, nil), nil).Once()
+		err := ipv6outboundports.WaitVerifyIPv6OutboundPortUpdate(context.TODO(), client, mockStack, mockPort, mockSubnets, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodesPoll {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genIPv6OutboundPortResp(statusCode, nil), nil).Once()
+				err := ipv6outboundports.WaitVerifyIPv6OutboundPortUpdate(context.TODO(), client, mockStack, mockPort, mockSubnets, true)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_limits_config"
+
+	schemaKeyStanza   = "stanza"
+	schemaKeySettings = "settings"
+)
+
+func limitsConfigResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyStanza: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			Description: "The limits.conf stanza to configure, e.g. search. No two resources should have the same stanza. " +
+				"Can not be updated after creation, if changed in config file terraform will propose a replacement.",
+		},
+		schemaKeySettings: {
+			Type:     schema.TypeMap,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DiffSuppressFunc: suppressEquivalentSettingValue,
+			Description: "Map of setting names to values of the stanza. Settings removed from the map are reset to their " +
+				"Splunk defaults.",
+		},
+	}
+}
+
+func ResourceLimitsConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Limits Config Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureLimits " +
+			"for more latest, detailed information on attribute requirements and the ACS Limits API.",
+
+		CreateContext: resourceLimitsConfigCreate,
+		ReadContext:   resourceLimitsConfigRead,
+		UpdateContext: resourceLimitsConfigUpdate,
+		DeleteContext: resourceLimitsConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceLimitsConfigCustomizeDiff,
+
+		Schema: limitsConfigResourceSchema(),
+	}
+}
+
+func resourceLimitsConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Get(schemaKeyStanza).(string)
+	settings := parseSettings(d.Get(schemaKeySettings))
+
+	if err := WaitLimitsConfigUpdate(ctx, acsClient, stack, stanza, settings); err != nil {
+		return diag.Errorf("Error submitting request for limits stanza (%s) to be updated: %s", stanza, err)
+	}
+
+	//Poll until settings have been confirmed updated
+	if err := WaitVerifyLimitsConfigUpdate(ctx, acsClient, stack, stanza, settings); err != nil {
+		return diag.Errorf("Error waiting for limits stanza (%s) to be updated: %s", stanza, err)
+	}
+
+	// Set ID of limits config resource to indicate settings of stanza are managed
+	d.SetId(stanza)
+	tflog.Info(ctx, fmt.Sprintf("Created limits config resource: %s\n", stanza))
+
+	// Call readLimitsConfig to set attributes of limits config
+	return resourceLimitsConfigRead(ctx, d, m)
+}
+
+func resourceLimitsConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Id()
+
+	currentSettings, err := WaitLimitsConfigRead(ctx, acsClient, stack, stanza)
+	if err != nil {
+		// if stanza not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing limits config from state. Not Found error while reading limits stanza (%s): %s.", stanza, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading limits stanza (%s): %s", stanza, err)
+	}
+
+	// Only the managed settings are tracked, on import all settings of the stanza are tracked
+	settings := currentSettings
+	if managedSettings := parseSettings(d.Get(schemaKeySettings)); len(managedSettings) > 0 {
+		settings = map[string]string{}
+		for key := range managedSettings {
+			if value, ok := currentSettings[key]; ok {
+				settings[key] = value
+			}
+		}
+	}
+
+	if err := d.Set(schemaKeyStanza, stanza); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeySettings, settings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLimitsConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Id()
+
+	// Determine the changes to the settings of the stanza
+	oldSettings, newSettings := d.GetChange(schemaKeySettings)
+	updateSettings, resetKeys := diffSettings(parseSettings(oldSettings), parseSettings(newSettings))
+
+	if len(resetKeys) > 0 {
+		if err := WaitLimitsConfigReset(ctx, acsClient, stack, stanza, resetKeys); err != nil {
+			return diag.Errorf("Error resetting settings of limits stanza (%s): %s", stanza, err)
+		}
+
+		//Poll until settings have been confirmed reset
+		if err := WaitVerifyLimitsConfigReset(ctx, acsClient, stack, stanza, resetKeys); err != nil {
+			return diag.Errorf("Error waiting for settings of limits stanza (%s) to be reset: %s", stanza, err)
+		}
+	}
+
+	if len(updateSettings) > 0 {
+		if err := WaitLimitsConfigUpdate(ctx, acsClient, stack, stanza, updateSettings); err != nil {
+			return diag.Errorf("Error updating limits stanza (%s): %s", stanza, err)
+		}
+
+		//Poll until settings have been confirmed updated
+		if err := WaitVerifyLimitsConfigUpdate(ctx, acsClient, stack, stanza, updateSettings); err != nil {
+			return diag.Errorf("Error waiting for limits stanza (%s) to be updated: %s", stanza, err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated limits config resource: %s\n", stanza))
+
+	return resourceLimitsConfigRead(ctx, d, m)
+}
+
+func resourceLimitsConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Id()
+
+	// Reset all managed settings so that the stanza returns to the Splunk defaults
+	_, resetKeys := diffSettings(parseSettings(d.Get(schemaKeySettings)), nil)
+	if len(resetKeys) > 0 {
+		if err := WaitLimitsConfigReset(ctx, acsClient, stack, stanza, resetKeys); err != nil {
+			return diag.Errorf("Error resetting settings of limits stanza (%s): %s", stanza, err)
+		}
+
+		//Poll until settings have been confirmed reset
+		if err := WaitVerifyLimitsConfigReset(ctx, acsClient, stack, stanza, resetKeys); err != nil {
+			return diag.Errorf("Error waiting for settings of limits stanza (%s) to be reset: %s", stanza, err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted limits config resource: %s\n", stanza))
+	return nil
+}
+
+// resourceLimitsConfigCustomizeDiff fails the plan if a setting does not exist in the stanza or its value is outside of the
+// bounds returned by the ACS limits defaults
+func resourceLimitsConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(schemaKeyStanza) || !d.NewValueKnown(schemaKeySettings) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange(schemaKeySettings) {
+		return nil
+	}
+
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Get(schemaKeyStanza).(string)
+	defaults, err := WaitLimitsConfigDefaultsRead(ctx, acsClient, stack, stanza)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return fmt.Errorf("limits stanza (%s) does not exist: %s", stanza, err)
+		}
+		return fmt.Errorf("error reading defaults of limits stanza (%s): %s", stanza, err)
+	}
+
+	return ValidateSettings(stanza, *defaults, parseSettings(d.Get(schemaKeySettings)))
+}
+
+// parseSettings converts the settings map of the resource data to a map of strings
+func parseSettings(rawSettings interface{}) map[string]string {
+	settings := map[string]string{}
+	rawSettingsMap, ok := rawSettings.(map[string]interface{})
+	if !ok {
+		return settings
+	}
+	for key, value := range rawSettingsMap {
+		settings[key] = value.(string)
+	}
+	return settings
+}
+
+// diffSettings returns the settings that were added or changed and the sorted keys of the settings that were removed
+func diffSettings(oldSettings map[string]string, newSettings map[string]string) (updateSettings map[string]string, resetKeys []string) {
+	updateSettings = map[string]string{}
+	for key, value := range newSettings {
+		if oldValue, ok := oldSettings[key]; !ok || !IsSettingValueEqual(oldValue, value) {
+			updateSettings[key] = value
+		}
+	}
+
+	resetKeys = make([]string, 0)
+	for key := range oldSettings {
+		if _, ok := newSettings[key]; !ok {
+			resetKeys = append(resetKeys, key)
+		}
+	}
+	sort.Strings(resetKeys)
+	return updateSettings, resetKeys
+}
+
+// suppressEquivalentSettingValue suppresses the diff between numeric setting values that are equal, e.g. 1 and 1.0
+func suppressEquivalentSettingValue(k, oldValue, newValue string, _ *schema.ResourceData) bool {
+	// the number of settings is not a setting value
+	if k == schemaKeySettings+".%" {
+		return false
+	}
+	return oldValue != "" && newValue != "" && IsSettingValueEqual(oldValue, newValue)
+}
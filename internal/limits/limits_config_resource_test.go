@@ -0,0 +1,53 @@
+package limits_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+func resourcePrefix(resourceName string) string {
+	return fmt.Sprint("scp_limits_config.", resourceName)
+}
+
+func TestAcc_SplunkCloudLimitsConfig(t *testing.T) {
+	resourceName := resource.UniqueId()
+
+	limitsConfigResourceTest := []resource.TestStep{
+		// Override settings of stanza
+		{
+			Config: testAccInstanceConfigLimitsConfig(resourceName, `max_mem_usage_mb = "400"
+			max_searches_per_cpu = "2"`),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "stanza", "search"),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "settings.max_mem_usage_mb", "400"),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "settings.max_searches_per_cpu", "2"),
+			),
+		},
+		// Change a setting and reset the other setting to its default
+		{
+			Config: testAccInstanceConfigLimitsConfig(resourceName, `max_mem_usage_mb = "500"`),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "settings.%", "1"),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "settings.max_mem_usage_mb", "500"),
+			),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps:             limitsConfigResourceTest,
+	})
+}
+
+func testAccInstanceConfigLimitsConfig(resourceName string, settings string) string {
+	return fmt.Sprintf(`resource "scp_limits_config" %[1]q {
+		stanza   = "search"
+		settings = {
+			%[2]s
+		}
+	}`, resourceName, settings)
+}
@@ -0,0 +1,234 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	DataSourceKey = "scp_limits"
+
+	schemaKeyStanzas      = "stanzas"
+	schemaKeyLimits       = "limits"
+	schemaKeyName         = "name"
+	schemaKeyValue        = "value"
+	schemaKeyDefaultValue = "default_value"
+	schemaKeyMinValue     = "min_value"
+	schemaKeyMaxValue     = "max_value"
+)
+
+// StanzaLimits are the settings of a limits.conf stanza
+type StanzaLimits struct {
+	Stanza   string
+	Settings []SettingLimits
+}
+
+// SettingLimits is the current value of a limits.conf setting along with its default and bounds, empty if not defined
+type SettingLimits struct {
+	Name         string
+	Value        string
+	DefaultValue string
+	MinValue     string
+	MaxValue     string
+}
+
+func limitsDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyStanzas: {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The limits.conf stanzas to read, e.g. search. Defaults to all stanzas.",
+		},
+		schemaKeyLimits: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyStanza: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The limits.conf stanza of the settings.",
+					},
+					schemaKeySettings: {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								schemaKeyName: {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The name of the setting.",
+								},
+								schemaKeyValue: {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The current effective value of the setting. Empty if the stack does not report a value.",
+								},
+								schemaKeyDefaultValue: {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The Splunk default value of the setting. Empty if the setting has no default.",
+								},
+								schemaKeyMinValue: {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The minimum value allowed for the setting. Empty if the setting has no minimum.",
+								},
+								schemaKeyMaxValue: {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The maximum value allowed for the setting. Empty if the setting has no maximum.",
+								},
+							},
+						},
+						Description: "The settings of the stanza, sorted by name.",
+					},
+				},
+			},
+			Description: "The settings of each stanza, sorted by stanza.",
+		},
+	}
+}
+
+func DataSourceLimits() *schema.Resource {
+	return &schema.Resource{
+		Description: "Limits Data Source. Use this data source to compare the current limits.conf settings of a stack with " +
+			"their Splunk defaults and bounds. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureLimits " +
+			"for more latest, detailed information on the ACS Limits API.",
+
+		ReadContext: dataSourceLimitsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: limitsDataSourceSchema(),
+	}
+}
+
+func dataSourceLimitsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	currentSettings, err := WaitAllLimitsConfigRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading limits configuration: %s", err)
+	}
+
+	defaults, err := WaitAllLimitsConfigDefaultsRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading limits configuration defaults: %s", err)
+	}
+
+	stanzas := map[string]bool{}
+	if values, ok := d.GetOk(schemaKeyStanzas); ok {
+		for _, stanza := range utils.ParseSetValues(values) {
+			stanzas[stanza] = true
+		}
+	}
+
+	limits := make([]map[string]interface{}, 0)
+	for _, stanzaLimits := range MergeLimits(currentSettings, defaults) {
+		if len(stanzas) > 0 && !stanzas[stanzaLimits.Stanza] {
+			continue
+		}
+
+		settings := make([]map[string]interface{}, 0, len(stanzaLimits.Settings))
+		for _, setting := range stanzaLimits.Settings {
+			settings = append(settings, map[string]interface{}{
+				schemaKeyName:         setting.Name,
+				schemaKeyValue:        setting.Value,
+				schemaKeyDefaultValue: setting.DefaultValue,
+				schemaKeyMinValue:     setting.MinValue,
+				schemaKeyMaxValue:     setting.MaxValue,
+			})
+		}
+		limits = append(limits, map[string]interface{}{
+			schemaKeyStanza:   stanzaLimits.Stanza,
+			schemaKeySettings: settings,
+		})
+	}
+	tflog.Info(ctx, fmt.Sprintf("Read limits of %d stanzas\n", len(limits)))
+
+	if err := d.Set(schemaKeyLimits, limits); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
+
+// MergeLimits combines the current settings and the defaults of all stanzas, sorted by stanza and setting name. Stanzas and
+// settings only present in one of both are included with the missing values left empty
+func MergeLimits(currentSettings map[string]map[string]string, defaults []v2.LimitStanza) []StanzaLimits {
+	merged := map[string]map[string]*SettingLimits{}
+	getSetting := func(stanza string, name string) *SettingLimits {
+		if _, ok := merged[stanza]; !ok {
+			merged[stanza] = map[string]*SettingLimits{}
+		}
+		if _, ok := merged[stanza][name]; !ok {
+			merged[stanza][name] = &SettingLimits{Name: name}
+		}
+		return merged[stanza][name]
+	}
+
+	for _, limitStanza := range defaults {
+		if limitStanza.Stanza == nil {
+			continue
+		}
+		if _, ok := merged[*limitStanza.Stanza]; !ok {
+			merged[*limitStanza.Stanza] = map[string]*SettingLimits{}
+		}
+		if limitStanza.Settings == nil {
+			continue
+		}
+		for _, limitSetting := range *limitStanza.Settings {
+			if limitSetting.Setting == nil {
+				continue
+			}
+			setting := getSetting(*limitStanza.Stanza, *limitSetting.Setting)
+			setting.DefaultValue = formatBound(limitSetting.DefaultValue, "")
+			setting.MinValue = formatBound(limitSetting.MinValue, "")
+			setting.MaxValue = formatBound(limitSetting.MaxValue, "")
+		}
+	}
+
+	for stanza, settings := range currentSettings {
+		if _, ok := merged[stanza]; !ok {
+			merged[stanza] = map[string]*SettingLimits{}
+		}
+		for name, value := range settings {
+			getSetting(stanza, name).Value = value
+		}
+	}
+
+	limits := make([]StanzaLimits, 0, len(merged))
+	for stanza, settings := range merged {
+		stanzaLimits := StanzaLimits{Stanza: stanza, Settings: make([]SettingLimits, 0, len(settings))}
+		for _, setting := range settings {
+			stanzaLimits.Settings = append(stanzaLimits.Settings, *setting)
+		}
+		sort.Slice(stanzaLimits.Settings, func(i, j int) bool {
+			return stanzaLimits.Settings[i].Name < stanzaLimits.Settings[j].Name
+		})
+		limits = append(limits, stanzaLimits)
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].Stanza < limits[j].Stanza
+	})
+	return limits
+}
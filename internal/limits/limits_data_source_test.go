@@ -0,0 +1,34 @@
+package limits_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const limitsDataSourceTemplate = `
+data "scp_limits" %[1]q {
+	stanzas = [%[2]q]
+}
+`
+
+func TestAcc_SplunkCloudLimits_DataSource_basic(t *testing.T) {
+	dataSourceName := "search"
+	stanza := "search"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(limitsDataSourceTemplate, dataSourceName, stanza),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.scp_limits.%s", dataSourceName), "limits.#", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.scp_limits.%s", dataSourceName), "limits.0.stanza", stanza),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_limits.%s", dataSourceName), "limits.0.settings.0.name"),
+				),
+			},
+		},
+	})
+}
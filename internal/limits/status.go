@@ -0,0 +1,402 @@
+package limits
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// limitConfigResponse is the response of the describe limits configuration endpoint of a stanza
+type limitConfigResponse struct {
+	Limitconfiguration *v2.LimitConfigurationResponse `json:"limitconfiguration,omitempty"`
+}
+
+// LimitsConfigStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the settings of the stanza
+func LimitsConfigStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetLimitConfig(ctx, stack, v2.Stanza(stanza))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		settings := map[string]string{}
+		if resp.StatusCode == http.StatusOK {
+			if settings, err = parseLimitConfigResponse(bodyBytes); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return settings, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// LimitsConfigStatusUpdate returns StateRefreshFunc that makes POST request to update settings of the stanza and checks if request was accepted
+func LimitsConfigStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, settings map[string]string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		updateSettings := make(map[string]interface{}, len(settings))
+		for key, value := range settings {
+			updateSettings[key] = ParseSettingValue(value)
+		}
+		resp, err := acsClient.AddLimitConfig(ctx, stack, v2.Stanza(stanza), v2.AddLimitConfigJSONRequestBody{Settings: &updateSettings})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// LimitsConfigStatusReset returns StateRefreshFunc that makes POST request to reset settings of the stanza to their defaults and checks if request was accepted
+func LimitsConfigStatusReset(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, keys []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resetSettings := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			resetSettings = append(resetSettings, key)
+		}
+		resp, err := acsClient.ResetLimitConfig(ctx, stack, v2.Stanza(stanza), v2.ResetLimitConfigJSONRequestBody{Settings: &resetSettings})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// LimitsConfigStatusVerifyUpdate returns a StateRefreshFunc that makes a GET request and checks to see if the settings of the stanza match the updated settings
+func LimitsConfigStatusVerifyUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, settings map[string]string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		currentSettings, statusText, err := LimitsConfigStatusRead(ctx, acsClient, stack, stanza)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return currentSettings, statusText, err
+		}
+
+		for key, value := range settings {
+			if !IsSettingValueEqual(currentSettings.(map[string]string)[key], value) {
+				return currentSettings, statusText, nil
+			}
+		}
+		return currentSettings, status.UpdatedStatus, nil
+	}
+}
+
+// LimitsConfigStatusVerifyReset returns a StateRefreshFunc that makes a GET request and checks to see if the reset settings of
+// the stanza are no longer returned or are back at their default values
+func LimitsConfigStatusVerifyReset(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, defaults map[string]string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		currentSettings, statusText, err := LimitsConfigStatusRead(ctx, acsClient, stack, stanza)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return currentSettings, statusText, err
+		}
+
+		for key, defaultValue := range defaults {
+			if value, ok := currentSettings.(map[string]string)[key]; ok && !IsSettingValueEqual(value, defaultValue) {
+				return currentSettings, statusText, nil
+			}
+		}
+		return currentSettings, status.UpdatedStatus, nil
+	}
+}
+
+// SettingDefaults returns the default values of the given settings of a stanza, settings without a default are omitted
+func SettingDefaults(defaults v2.LimitStanza, keys []string) map[string]string {
+	settingDefaults := map[string]string{}
+	if defaults.Settings == nil {
+		return settingDefaults
+	}
+	for _, limitSetting := range *defaults.Settings {
+		if limitSetting.Setting == nil || limitSetting.DefaultValue == nil || !slices.Contains(keys, *limitSetting.Setting) {
+			continue
+		}
+		settingDefaults[*limitSetting.Setting] = FormatSettingValue(*limitSetting.DefaultValue)
+	}
+	return settingDefaults
+}
+
+// ParseSettingValue converts a setting value to the JSON type expected by the ACS API. Infinite and NaN values, which can
+// not be encoded as JSON numbers, are sent as strings.
+func ParseSettingValue(value string) interface{} {
+	if number, err := strconv.ParseFloat(value, 64); err == nil && !math.IsInf(number, 0) && !math.IsNaN(number) {
+		return number
+	}
+	if boolean, err := strconv.ParseBool(value); err == nil {
+		return boolean
+	}
+	return value
+}
+
+// FormatSettingValue converts a setting value returned by the ACS API to its string representation
+func FormatSettingValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// IsSettingValueEqual returns true if both setting values are equal, numeric values are compared by their value so that 1 and 1.0 are equal
+func IsSettingValueEqual(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	numberA, errA := strconv.ParseFloat(a, 64)
+	numberB, errB := strconv.ParseFloat(b, 64)
+	return errA == nil && errB == nil && numberA == numberB
+}
+
+// parseLimitConfigResponse returns the settings of a describe limits configuration response. Each entry of the settings
+// is either an object with setting and value fields or an object of setting names to values
+func parseLimitConfigResponse(bodyBytes []byte) (map[string]string, error) {
+	var limitConfig limitConfigResponse
+	if err := json.Unmarshal(bodyBytes, &limitConfig); err != nil {
+		return nil, err
+	}
+
+	if limitConfig.Limitconfiguration == nil || limitConfig.Limitconfiguration.Settings == nil {
+		return map[string]string{}, nil
+	}
+	return parseLimitSettings(*limitConfig.Limitconfiguration.Settings)
+}
+
+// parseLimitSettings returns the settings of a list of settings entries. Each entry is either an object with setting and
+// value fields or an object of setting names to values
+func parseLimitSettings(rawSettings []interface{}) (map[string]string, error) {
+	settings := map[string]string{}
+	for _, rawSetting := range rawSettings {
+		setting, ok := rawSetting.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected limits setting format: %v", rawSetting)
+		}
+		if name, ok := setting["setting"].(string); ok {
+			settings[name] = FormatSettingValue(setting["value"])
+			continue
+		}
+		for name, value := range setting {
+			settings[name] = FormatSettingValue(value)
+		}
+	}
+	return settings, nil
+}
+
+// parseAllLimitConfigResponse returns the settings of each stanza of a list limits configuration response. The limit
+// configuration is either a list of objects with stanza and settings fields or an object of stanza names to settings
+func parseAllLimitConfigResponse(bodyBytes []byte) (map[string]map[string]string, error) {
+	var limitConfig struct {
+		Limitconfiguration interface{} `json:"limitconfiguration,omitempty"`
+	}
+	if err := json.Unmarshal(bodyBytes, &limitConfig); err != nil {
+		return nil, err
+	}
+
+	stanzas := map[string]map[string]string{}
+	switch rawStanzas := limitConfig.Limitconfiguration.(type) {
+	case nil:
+		return stanzas, nil
+	case []interface{}:
+		for _, rawStanza := range rawStanzas {
+			stanza, ok := rawStanza.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("unexpected limits stanza format: %v", rawStanza)
+			}
+			name, ok := stanza["stanza"].(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected limits stanza format: %v", rawStanza)
+			}
+			settings, err := parseLimitStanzaSettings(stanza["settings"])
+			if err != nil {
+				return nil, err
+			}
+			stanzas[name] = settings
+		}
+	case map[string]interface{}:
+		for name, rawStanza := range rawStanzas {
+			// the settings of a stanza are either listed directly or nested in a settings field
+			if stanza, ok := rawStanza.(map[string]interface{}); ok {
+				rawStanza = stanza["settings"]
+			}
+			settings, err := parseLimitStanzaSettings(rawStanza)
+			if err != nil {
+				return nil, err
+			}
+			stanzas[name] = settings
+		}
+	default:
+		return nil, fmt.Errorf("unexpected limits configuration format: %v", rawStanzas)
+	}
+	return stanzas, nil
+}
+
+// parseLimitStanzaSettings returns the settings of the raw settings list of a stanza
+func parseLimitStanzaSettings(rawSettings interface{}) (map[string]string, error) {
+	if rawSettings == nil {
+		return map[string]string{}, nil
+	}
+	settingsList, ok := rawSettings.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected limits settings format: %v", rawSettings)
+	}
+	return parseLimitSettings(settingsList)
+}
+
+// LimitsConfigDefaultsStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the defaults and bounds of the settings of the stanza
+func LimitsConfigDefaultsStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetLimitsConfigDefaults(ctx, stack, v2.Stanza(stanza))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var limitStanza v2.LimitStanza
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &limitStanza); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &limitStanza, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// AllLimitsConfigStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the settings of all stanzas
+func AllLimitsConfigStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetAllLimitsConfig(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		stanzas := map[string]map[string]string{}
+		if resp.StatusCode == http.StatusOK {
+			if stanzas, err = parseAllLimitConfigResponse(bodyBytes); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return stanzas, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// AllLimitsConfigDefaultsStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the defaults and bounds of the settings of all stanzas
+func AllLimitsConfigDefaultsStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetAllLimitsConfigDefaults(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		limitStanzas := make([]v2.LimitStanza, 0)
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &limitStanzas); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return limitStanzas, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// ValidateSettings returns an error listing every setting that does not exist in the stanza defaults or whose value is outside of its bounds
+func ValidateSettings(stanza string, defaults v2.LimitStanza, settings map[string]string) error {
+	limitSettings := map[string]v2.LimitSetting{}
+	if defaults.Settings != nil {
+		for _, limitSetting := range *defaults.Settings {
+			if limitSetting.Setting != nil {
+				limitSettings[*limitSetting.Setting] = limitSetting
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, key := range keys {
+		value := settings[key]
+		limitSetting, ok := limitSettings[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("setting (%s) does not exist in limits stanza (%s)", key, stanza))
+			continue
+		}
+		if limitSetting.MinValue == nil && limitSetting.MaxValue == nil {
+			continue
+		}
+
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("value (%s) of setting (%s) must be a number", value, key))
+			continue
+		}
+		if (limitSetting.MinValue != nil && number < *limitSetting.MinValue) || (limitSetting.MaxValue != nil && number > *limitSetting.MaxValue) {
+			errs = append(errs, fmt.Errorf("value (%s) of setting (%s) must be between %s and %s",
+				value, key, formatBound(limitSetting.MinValue, "-inf"), formatBound(limitSetting.MaxValue, "+inf")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// formatBound returns the string representation of a setting bound or the fallback if the setting has no such bound
+func formatBound(bound *float64, fallback string) string {
+	if bound == nil {
+		return fallback
+	}
+	return FormatSettingValue(*bound)
+}
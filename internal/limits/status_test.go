@@ -0,0 +1,189 @@
+package limits_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/limits"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_LimitsConfigStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with settings as setting and value objects", func(t *testing.T) {
+		body := `{"limitconfiguration": {"settings": [{"setting": "max_mem_usage_mb", "value": 200}, {"setting": "enable_history", "value": true}]}}`
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		settings, _, err := limits.LimitsConfigStatusRead(context.TODO(), client, mockStack, mockStanza)()
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"max_mem_usage_mb": "200", "enable_history": "true"}, settings)
+	})
+
+	t.Run("with settings as name to value objects", func(t *testing.T) {
+		body := `{"limitconfiguration": {"settings": [{"max_mem_usage_mb": 200.5}, {"search_process_mode": "auto"}]}}`
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		settings, _, err := limits.LimitsConfigStatusRead(context.TODO(), client, mockStack, mockStanza)()
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"max_mem_usage_mb": "200.5", "search_process_mode": "auto"}, settings)
+	})
+
+	t.Run("with unexpected settings format", func(t *testing.T) {
+		body := `{"limitconfiguration": {"settings": ["max_mem_usage_mb"]}}`
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		_, _, err := limits.LimitsConfigStatusRead(context.TODO(), client, mockStack, mockStanza)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_AllLimitsConfigStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	expected := map[string]map[string]string{
+		"search":   {"max_mem_usage_mb": "200", "enable_history": "true"},
+		"realtime": {"indexfilter": "true"},
+		"kv":       {},
+	}
+
+	t.Run("with stanzas as stanza and settings objects", func(t *testing.T) {
+		body := `{"limitconfiguration": [{"stanza": "search", "settings": [{"setting": "max_mem_usage_mb", "value": 200}, {"enable_history": true}]},
+			{"stanza": "realtime", "settings": [{"indexfilter": true}]}, {"stanza": "kv"}]}`
+		client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		stanzas, _, err := limits.AllLimitsConfigStatusRead(context.TODO(), client, mockStack)()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, stanzas)
+	})
+
+	t.Run("with stanza names to settings", func(t *testing.T) {
+		body := `{"limitconfiguration": {"search": {"settings": [{"setting": "max_mem_usage_mb", "value": 200}, {"enable_history": true}]},
+			"realtime": [{"indexfilter": true}], "kv": []}}`
+		client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		stanzas, _, err := limits.AllLimitsConfigStatusRead(context.TODO(), client, mockStack)()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, stanzas)
+	})
+
+	t.Run("with unexpected stanza format", func(t *testing.T) {
+		body := `{"limitconfiguration": ["search"]}`
+		client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		_, _, err := limits.AllLimitsConfigStatusRead(context.TODO(), client, mockStack)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_MergeLimits(t *testing.T) {
+	current := map[string]map[string]string{
+		mockStanza: {"max_mem_usage_mb": "400", "enable_history": "true"},
+		"kv":       {"max_threads": "10"},
+	}
+
+	expected := []limits.StanzaLimits{
+		{Stanza: "kv", Settings: []limits.SettingLimits{{Name: "max_threads", Value: "10"}}},
+		{Stanza: mockStanza, Settings: []limits.SettingLimits{
+			{Name: "enable_history", Value: "true"},
+			{Name: "max_mem_usage_mb", Value: "400", DefaultValue: "200", MinValue: "100", MaxValue: "20000"},
+			{Name: "max_searches_per_cpu", DefaultValue: "1", MinValue: "1"},
+			{Name: "search_process_mode"},
+		}},
+	}
+	assert.Equal(t, expected, limits.MergeLimits(current, []v2.LimitStanza{genLimitStanza()}))
+}
+
+func Test_ParseSettingValue(t *testing.T) {
+	assert.Equal(t, float64(200), limits.ParseSettingValue("200"))
+	assert.Equal(t, 0.5, limits.ParseSettingValue("0.5"))
+	assert.Equal(t, true, limits.ParseSettingValue("true"))
+	assert.Equal(t, "auto", limits.ParseSettingValue("auto"))
+	assert.Equal(t, "inf", limits.ParseSettingValue("inf"))
+	assert.Equal(t, "NaN", limits.ParseSettingValue("NaN"))
+}
+
+func Test_SettingDefaults(t *testing.T) {
+	defaults := limits.SettingDefaults(genLimitStanza(), []string{"max_mem_usage_mb", "search_process_mode"})
+	assert.Equal(t, map[string]string{"max_mem_usage_mb": "200"}, defaults)
+}
+
+func Test_IsSettingValueEqual(t *testing.T) {
+	assert.True(t, limits.IsSettingValueEqual("1", "1.0"))
+	assert.True(t, limits.IsSettingValueEqual("auto", "auto"))
+	assert.False(t, limits.IsSettingValueEqual("1", "2"))
+	assert.False(t, limits.IsSettingValueEqual("", "0"))
+}
+
+func Test_ValidateSettings(t *testing.T) {
+	defaults := genLimitStanza()
+
+	t.Run("with valid settings", func(t *testing.T) {
+		err := limits.ValidateSettings(mockStanza, defaults, map[string]string{"max_mem_usage_mb": "400", "search_process_mode": "auto"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unknown setting", func(t *testing.T) {
+		err := limits.ValidateSettings(mockStanza, defaults, map[string]string{"max_mem_usage": "400"})
+		assert.ErrorContains(t, err, "setting (max_mem_usage) does not exist in limits stanza (search)")
+	})
+
+	t.Run("with value out of range", func(t *testing.T) {
+		err := limits.ValidateSettings(mockStanza, defaults, map[string]string{"max_mem_usage_mb": "50000", "max_searches_per_cpu": "0"})
+		assert.ErrorContains(t, err, "value (50000) of setting (max_mem_usage_mb) must be between 100 and 20000")
+		assert.ErrorContains(t, err, "value (0) of setting (max_searches_per_cpu) must be between 1 and +inf")
+	})
+
+	t.Run("with non numeric value of bounded setting", func(t *testing.T) {
+		err := limits.ValidateSettings(mockStanza, defaults, map[string]string{"max_mem_usage_mb": "auto"})
+		assert.ErrorContains(t, err, "value (auto) of setting (max_mem_usage_mb) must be a number")
+	})
+}
+
+func genLimitStanza() v2.LimitStanza {
+	stanza := mockStanza
+	settings := []v2.LimitSetting{
+		{Setting: toPtr("max_mem_usage_mb"), DefaultValue: toPtr(float64(200)), MinValue: toPtr(float64(100)), MaxValue: toPtr(float64(20000))},
+		{Setting: toPtr("max_searches_per_cpu"), DefaultValue: toPtr(float64(1)), MinValue: toPtr(float64(1))},
+		{Setting: toPtr("search_process_mode")},
+	}
+	return v2.LimitStanza{Stanza: &stanza, Settings: &settings}
+}
+
+func genLimitStanzaResp(statusCode int) *http.Response {
+	if statusCode != http.StatusOK {
+		return genLimitConfigResp(statusCode, nil)
+	}
+	b, _ := json.Marshal(genLimitStanza())
+	return genRawResp(statusCode, string(b))
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
+
+func genLimitConfigResp(statusCode int, settings map[string]string) *http.Response {
+	if statusCode != http.StatusOK {
+		b, _ := json.Marshal(&v2.Error{
+			Code:    http.StatusText(statusCode),
+			Message: http.StatusText(statusCode),
+		})
+		return genRawResp(statusCode, string(b))
+	}
+
+	rawSettings := make([]interface{}, 0, len(settings))
+	for key, value := range settings {
+		rawSettings = append(rawSettings, map[string]interface{}{"setting": key, "value": limits.ParseSettingValue(value)})
+	}
+	b, _ := json.Marshal(map[string]interface{}{
+		"limitconfiguration": v2.LimitConfigurationResponse{Settings: &rawSettings},
+	})
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
@@ -0,0 +1,142 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// Limits settings are read back until the accepted update has been applied
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitLimitsConfigRead Handles retry logic for GET requests for the read lifecycle function
+func WaitLimitsConfigRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string) (map[string]string, error) {
+	waitLimitsConfigRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, LimitsConfigStatusRead(ctx, acsClient, stack, stanza))
+
+	output, err := waitLimitsConfigRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading limits stanza (%s): %s", stanza, err))
+		return nil, err
+	}
+	settings := output.(map[string]string)
+
+	return settings, nil
+}
+
+// WaitLimitsConfigUpdate Handles retry logic for POST requests for the create and update lifecycle functions
+func WaitLimitsConfigUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, settings map[string]string) error {
+	waitLimitsConfigUpdateAccepted := wait.GenerateWriteStateChangeConf(LimitsConfigStatusUpdate(ctx, acsClient, stack, stanza, settings))
+
+	rawResp, err := waitLimitsConfigUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for limits stanza (%s) to be updated: %s", stanza, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for limits stanza (%s): %d\n", stanza, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for limits stanza (%s): %s\n", stanza, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitLimitsConfigReset Handles retry logic for POST requests resetting settings for the update and delete lifecycle functions
+func WaitLimitsConfigReset(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, keys []string) error {
+	waitLimitsConfigResetAccepted := wait.GenerateWriteStateChangeConf(LimitsConfigStatusReset(ctx, acsClient, stack, stanza, keys))
+
+	rawResp, err := waitLimitsConfigResetAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for limits stanza (%s) to be reset: %s", stanza, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and reset in progress
+	tflog.Info(ctx, fmt.Sprintf("Reset response status code for limits stanza (%s): %d\n", stanza, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for limits stanza (%s): %s\n", stanza, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyLimitsConfigUpdate Handles retry logic for GET request for the create and update lifecycle functions to verify that settings of the stanza were updated
+func WaitVerifyLimitsConfigUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, settings map[string]string) error {
+	waitLimitsConfigUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, LimitsConfigStatusVerifyUpdate(ctx, acsClient, stack, stanza, settings))
+
+	_, err := waitLimitsConfigUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming limits stanza (%s) has been updated: %s", stanza, err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitVerifyLimitsConfigReset Handles retry logic for GET request for the update and delete lifecycle functions to verify that
+// reset settings of the stanza are back at their defaults
+func WaitVerifyLimitsConfigReset(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, keys []string) error {
+	defaults, err := WaitLimitsConfigDefaultsRead(ctx, acsClient, stack, stanza)
+	if err != nil {
+		return err
+	}
+
+	waitLimitsConfigReset := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, LimitsConfigStatusVerifyReset(ctx, acsClient, stack, stanza, SettingDefaults(*defaults, keys)))
+
+	if _, err = waitLimitsConfigReset.WaitForStateContext(ctx); err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming limits stanza (%s) has been reset: %s", stanza, err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitLimitsConfigDefaultsRead Handles retry logic for GET requests reading the defaults and bounds of the settings of a stanza
+func WaitLimitsConfigDefaultsRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string) (*v2.LimitStanza, error) {
+	waitLimitsConfigDefaultsRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, LimitsConfigDefaultsStatusRead(ctx, acsClient, stack, stanza))
+
+	output, err := waitLimitsConfigDefaultsRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading defaults of limits stanza (%s): %s", stanza, err))
+		return nil, err
+	}
+	defaults := output.(*v2.LimitStanza)
+
+	return defaults, nil
+}
+
+// WaitAllLimitsConfigRead Handles retry logic for GET requests reading the settings of all stanzas
+func WaitAllLimitsConfigRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (map[string]map[string]string, error) {
+	waitAllLimitsConfigRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, AllLimitsConfigStatusRead(ctx, acsClient, stack))
+
+	output, err := waitAllLimitsConfigRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading limits configuration: %s", err))
+		return nil, err
+	}
+	stanzas := output.(map[string]map[string]string)
+
+	return stanzas, nil
+}
+
+// WaitAllLimitsConfigDefaultsRead Handles retry logic for GET requests reading the defaults and bounds of the settings of all stanzas
+func WaitAllLimitsConfigDefaultsRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.LimitStanza, error) {
+	waitAllLimitsConfigDefaultsRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, AllLimitsConfigDefaultsStatusRead(ctx, acsClient, stack))
+
+	output, err := waitAllLimitsConfigDefaultsRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading limits configuration defaults: %s", err))
+		return nil, err
+	}
+	limitStanzas := output.([]v2.LimitStanza)
+
+	return limitStanzas, nil
+}
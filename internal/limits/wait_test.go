@@ -0,0 +1,296 @@
+package limits_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/limits"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack  = "mock-stack"
+	mockStanza = "search"
+)
+
+var (
+	unexpectedStatusCodes     = []int{400, 401, 403, 404, 409, 501, 503}
+	unexpectedStatusCodesPoll = []int{400, 401, 403, 404, 409, 501, 500, 503}
+
+	mockSettings        = map[string]string{"max_mem_usage_mb": "200"}
+	mockSettingsUpdated = map[string]string{"max_mem_usage_mb": "400"}
+	mockResetKeys       = []string{"max_mem_usage_mb"}
+)
+
+func Test_WaitLimitsConfigRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(nil, errors.New("some error")).Once()
+		settings, err := limits.WaitLimitsConfigRead(context.TODO(), client, mockStack, mockStanza)
+		assert.Error(t, err)
+		assert.Nil(t, settings)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, mockSettings), nil).Once()
+		settings, err := limits.WaitLimitsConfigRead(context.TODO(), client, mockStack, mockStanza)
+		assert.NoError(t, err)
+		assert.Equal(t, mockSettings, settings)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, mockSettings), nil).Once()
+		settings, err := limits.WaitLimitsConfigRead(context.TODO(), client, mockStack, mockStanza)
+		assert.NoError(t, err)
+		assert.Equal(t, mockSettings, settings)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(statusCode, nil), nil).Once()
+				settings, err := limits.WaitLimitsConfigRead(context.TODO(), client, mockStack, mockStanza)
+				assert.Error(t, err)
+				assert.Nil(t, settings)
+			})
+		}
+	})
+}
+
+func Test_WaitLimitsConfigUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	updateBody := v2.AddLimitConfigJSONRequestBody{Settings: &map[string]interface{}{"max_mem_usage_mb": float64(200)}}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), updateBody).Return(nil, errors.New("some error")).Once()
+		err := limits.WaitLimitsConfigUpdate(context.TODO(), client, mockStack, mockStanza, mockSettings)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), updateBody).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := limits.WaitLimitsConfigUpdate(context.TODO(), client, mockStack, mockStanza, mockSettings)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), updateBody).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), updateBody).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := limits.WaitLimitsConfigUpdate(context.TODO(), client, mockStack, mockStanza, mockSettings)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), updateBody).Return(genRawResp(statusCode, ""), nil).Once()
+				err := limits.WaitLimitsConfigUpdate(context.TODO(), client, mockStack, mockStanza, mockSettings)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitLimitsConfigReset(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	resetBody := v2.ResetLimitConfigJSONRequestBody{Settings: &[]interface{}{"max_mem_usage_mb"}}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ResetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), resetBody).Return(nil, errors.New("some error")).Once()
+		err := limits.WaitLimitsConfigReset(context.TODO(), client, mockStack, mockStanza, mockResetKeys)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("ResetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), resetBody).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := limits.WaitLimitsConfigReset(context.TODO(), client, mockStack, mockStanza, mockResetKeys)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ResetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), resetBody).Return(genRawResp(statusCode, ""), nil).Once()
+				err := limits.WaitLimitsConfigReset(context.TODO(), client, mockStack, mockStanza, mockResetKeys)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyLimitsConfigUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with settings updated after pending update", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, mockSettings), nil).Once()
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, mockSettingsUpdated), nil).Once()
+		err := limits.WaitVerifyLimitsConfigUpdate(context.TODO(), client, mockStack, mockStanza, mockSettingsUpdated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodesPoll {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(statusCode, nil), nil).Once()
+				err := limits.WaitVerifyLimitsConfigUpdate(context.TODO(), client, mockStack, mockStanza, mockSettingsUpdated)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyLimitsConfigReset(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with settings reset after pending reset", func(t *testing.T) {
+		client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitStanzaResp(http.StatusOK), nil).Once()
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, mockSettingsUpdated), nil).Once()
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, mockSettings), nil).Once()
+		err := limits.WaitVerifyLimitsConfigReset(context.TODO(), client, mockStack, mockStanza, mockResetKeys)
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with reset settings no longer returned", func(t *testing.T) {
+		client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitStanzaResp(http.StatusOK), nil).Once()
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, map[string]string{}), nil).Once()
+		err := limits.WaitVerifyLimitsConfigReset(context.TODO(), client, mockStack, mockStanza, mockResetKeys)
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodesPoll {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitStanzaResp(http.StatusOK), nil).Once()
+				client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(statusCode, nil), nil).Once()
+				err := limits.WaitVerifyLimitsConfigReset(context.TODO(), client, mockStack, mockStanza, mockResetKeys)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitLimitsConfigDefaultsRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(nil, errors.New("some error")).Once()
+		defaults, err := limits.WaitLimitsConfigDefaultsRead(context.TODO(), client, mockStack, mockStanza)
+		assert.Error(t, err)
+		assert.Nil(t, defaults)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitStanzaResp(http.StatusOK), nil).Once()
+		defaults, err := limits.WaitLimitsConfigDefaultsRead(context.TODO(), client, mockStack, mockStanza)
+		assert.NoError(t, err)
+		assert.Equal(t, genLimitStanza(), *defaults)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitStanzaResp(http.StatusTooManyRequests), nil).Once()
+		client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitStanzaResp(http.StatusOK), nil).Once()
+		defaults, err := limits.WaitLimitsConfigDefaultsRead(context.TODO(), client, mockStack, mockStanza)
+		assert.NoError(t, err)
+		assert.Equal(t, genLimitStanza(), *defaults)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitStanzaResp(statusCode), nil).Once()
+				defaults, err := limits.WaitLimitsConfigDefaultsRead(context.TODO(), client, mockStack, mockStanza)
+				assert.Error(t, err)
+				assert.Nil(t, defaults)
+			})
+		}
+	})
+}
+
+func Test_WaitAllLimitsConfigRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	body := `{"limitconfiguration": [{"stanza": "search", "settings": [{"setting": "max_mem_usage_mb", "value": 200}]}]}`
+	expected := map[string]map[string]string{mockStanza: mockSettings}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		stanzas, err := limits.WaitAllLimitsConfigRead(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, stanzas)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		stanzas, err := limits.WaitAllLimitsConfigRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, stanzas)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(genLimitConfigResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, body), nil).Once()
+		stanzas, err := limits.WaitAllLimitsConfigRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, stanzas)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetAllLimitsConfig", mock.Anything, v2.Stack(mockStack)).Return(genLimitConfigResp(statusCode, nil), nil).Once()
+				stanzas, err := limits.WaitAllLimitsConfigRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, stanzas)
+			})
+		}
+	})
+}
+
+func Test_WaitAllLimitsConfigDefaultsRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	b, _ := json.Marshal([]v2.LimitStanza{genLimitStanza()})
+	expected := []v2.LimitStanza{genLimitStanza()}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("GetAllLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		defaults, err := limits.WaitAllLimitsConfigDefaultsRead(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, defaults)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetAllLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, string(b)), nil).Once()
+		defaults, err := limits.WaitAllLimitsConfigDefaultsRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, defaults)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetAllLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack)).Return(genLimitConfigResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("GetAllLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusOK, string(b)), nil).Once()
+		defaults, err := limits.WaitAllLimitsConfigDefaultsRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, defaults)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetAllLimitsConfigDefaults", mock.Anything, v2.Stack(mockStack)).Return(genLimitConfigResp(statusCode, nil), nil).Once()
+				defaults, err := limits.WaitAllLimitsConfigDefaultsRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, defaults)
+			})
+		}
+	})
+}
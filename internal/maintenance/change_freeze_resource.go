@@ -0,0 +1,270 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	ChangeFreezeResourceKey = "scp_maintenance_change_freeze"
+
+	// ChangeFreezeDateLayout is the YYYY/MM/DD format of the start and end dates of a change freeze
+	ChangeFreezeDateLayout = "2006/01/02"
+
+	schemaKeyAppliesTo             = "applies_to"
+	schemaKeyStartDate             = "start_date"
+	schemaKeyEndDate               = "end_date"
+	schemaKeyReason                = "reason"
+	schemaKeyTickets               = "tickets"
+	schemaKeyCreatedTimestamp      = "created_timestamp"
+	schemaKeyLastModifiedTimestamp = "last_modified_timestamp"
+)
+
+func changeFreezeResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyAppliesTo: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "Type of changes the change freeze applies to.",
+		},
+		schemaKeyStartDate: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validateChangeFreezeDate),
+			Description:      "Date (YYYY/MM/DD) when the change freeze starts.",
+		},
+		schemaKeyEndDate: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validateChangeFreezeDate),
+			Description:      "Date (YYYY/MM/DD) when the change freeze ends. Must not be before the start date.",
+		},
+		schemaKeyReason: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "Reason for the change freeze.",
+		},
+		schemaKeyTickets: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "SFDC tickets associated with the change freeze request.",
+		},
+		schemaKeyCreatedTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the change freeze was created. Format is RFC3339.",
+		},
+		schemaKeyLastModifiedTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the change freeze was last modified. Format is RFC3339.",
+		},
+	}
+}
+
+func ResourceChangeFreeze() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maintenance Change Freeze Resource. Manages a single customer initiated change freeze of the maintenance " +
+			"windows preferences without taking ownership of the other change freezes of the stack. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageMaintenanceWindows " +
+			"for more latest, detailed information on attribute requirements and the ACS Maintenance Windows API.",
+
+		CreateContext: resourceChangeFreezeCreate,
+		ReadContext:   resourceChangeFreezeRead,
+		UpdateContext: resourceChangeFreezeUpdate,
+		DeleteContext: resourceChangeFreezeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceChangeFreezeCustomizeDiff,
+
+		Schema: changeFreezeResourceSchema(),
+	}
+}
+
+func resourceChangeFreezeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	freeze := parseChangeFreezeRequest(d)
+
+	// The change freezes that exist when the update is sent are used to tell the new change freeze apart once it has an ID
+	var existingIDs []string
+	merge := func(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+		existingIDs = ChangeFreezeIDs(current)
+		return MergeChangeFreeze(current, freeze)
+	}
+
+	if err := WaitChangeFreezeApply(ctx, acsClient, stack, merge); err != nil {
+		return diag.Errorf("Error submitting request for change freeze (%s - %s) to be created: %s", freeze.StartDate, freeze.EndDate, err)
+	}
+
+	//Poll until the change freeze has been added and assigned an ID
+	created, err := WaitVerifyChangeFreezeCreate(ctx, acsClient, stack, freeze, existingIDs)
+	if err != nil {
+		return diag.Errorf("Error waiting for change freeze (%s - %s) to be created: %s", freeze.StartDate, freeze.EndDate, err)
+	}
+
+	// Set ID of change freeze resource to the ID assigned by ACS
+	d.SetId(created.Id)
+	tflog.Info(ctx, fmt.Sprintf("Created change freeze resource: %s\n", created.Id))
+
+	// Call readChangeFreeze to set attributes of change freeze
+	return resourceChangeFreezeRead(ctx, d, m)
+}
+
+func resourceChangeFreezeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	id := d.Id()
+
+	preferences, err := WaitMaintenancePreferencesRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading change freeze (%s): %s", id, err)
+	}
+
+	// if change freeze was removed outside of terraform or has expired remove it from state
+	freeze := FindChangeFreeze(preferences.ChangeFreezes.CustomerInitiatedFreezes, id)
+	if freeze == nil {
+		tflog.Info(ctx, fmt.Sprintf("Removing change freeze from state. Change freeze (%s) not found in maintenance windows preferences.", id))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(schemaKeyAppliesTo, freeze.AppliesTo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStartDate, freeze.StartDate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyEndDate, freeze.EndDate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyReason, freeze.Reason); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyTickets, freeze.Tickets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyCreatedTimestamp, freeze.CreatedTimestamp.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyLastModifiedTimestamp, freeze.LastModifiedTimestamp.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceChangeFreezeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	id := d.Id()
+	freeze := parseChangeFreezeRequest(d)
+	freeze.Id = &id
+
+	merge := func(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+		return MergeChangeFreeze(current, freeze)
+	}
+
+	if err := WaitChangeFreezeApply(ctx, acsClient, stack, merge); err != nil {
+		return diag.Errorf("Error updating change freeze (%s): %s", id, err)
+	}
+
+	//Poll until change freeze has been confirmed updated
+	if err := WaitVerifyChangeFreezeUpdate(ctx, acsClient, stack, id, freeze, true); err != nil {
+		return diag.Errorf("Error waiting for change freeze (%s) to be updated: %s", id, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated change freeze resource: %s\n", id))
+
+	return resourceChangeFreezeRead(ctx, d, m)
+}
+
+func resourceChangeFreezeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	id := d.Id()
+
+	merge := func(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+		return RemoveChangeFreeze(current, id), nil
+	}
+
+	if err := WaitChangeFreezeApply(ctx, acsClient, stack, merge); err != nil {
+		return diag.Errorf("Error deleting change freeze (%s): %s", id, err)
+	}
+
+	//Poll until change freeze has been confirmed removed
+	if err := WaitVerifyChangeFreezeUpdate(ctx, acsClient, stack, id, v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{}, false); err != nil {
+		return diag.Errorf("Error waiting for change freeze (%s) to be deleted: %s", id, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted change freeze resource: %s\n", id))
+	return nil
+}
+
+// resourceChangeFreezeCustomizeDiff fails the plan if the change freeze ends before it starts
+func resourceChangeFreezeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(schemaKeyStartDate) || !d.NewValueKnown(schemaKeyEndDate) {
+		return nil
+	}
+
+	startDate, errStart := time.Parse(ChangeFreezeDateLayout, d.Get(schemaKeyStartDate).(string))
+	endDate, errEnd := time.Parse(ChangeFreezeDateLayout, d.Get(schemaKeyEndDate).(string))
+	if errStart == nil && errEnd == nil && endDate.Before(startDate) {
+		return fmt.Errorf("end_date (%s) of change freeze must not be before start_date (%s)", endDate.Format(ChangeFreezeDateLayout), startDate.Format(ChangeFreezeDateLayout))
+	}
+	return nil
+}
+
+func parseChangeFreezeRequest(d *schema.ResourceData) v2.MaintenanceWindowsCustomerInitiatedFreezeRequest {
+	return v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+		AppliesTo: d.Get(schemaKeyAppliesTo).(string),
+		StartDate: d.Get(schemaKeyStartDate).(string),
+		EndDate:   d.Get(schemaKeyEndDate).(string),
+		Reason:    d.Get(schemaKeyReason).(string),
+	}
+}
+
+// validateChangeFreezeDate validates that a change freeze date is in the YYYY/MM/DD format
+func validateChangeFreezeDate(i interface{}, k string) (warnings []string, errs []error) {
+	value, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.Parse(ChangeFreezeDateLayout, value); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a date in the YYYY/MM/DD format, got %s", k, value)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,60 @@
+package maintenance_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/maintenance"
+)
+
+func resourcePrefix(resourceName string) string {
+	return fmt.Sprint("scp_maintenance_change_freeze.", resourceName)
+}
+
+func TestAcc_SplunkCloudMaintenanceChangeFreeze(t *testing.T) {
+	resourceName := resource.UniqueId()
+	startDate := time.Now().AddDate(0, 2, 0).Format(maintenance.ChangeFreezeDateLayout)
+	endDate := time.Now().AddDate(0, 2, 7).Format(maintenance.ChangeFreezeDateLayout)
+	endDateUpdate := time.Now().AddDate(0, 2, 14).Format(maintenance.ChangeFreezeDateLayout)
+
+	changeFreezeResourceTest := []resource.TestStep{
+		// Create change freeze
+		{
+			Config: testAccInstanceConfigChangeFreeze(resourceName, startDate, endDate),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "start_date", startDate),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "end_date", endDate),
+				resource.TestCheckResourceAttrSet(resourcePrefix(resourceName), "created_timestamp"),
+			),
+		},
+		// Extend change freeze
+		{
+			Config: testAccInstanceConfigChangeFreeze(resourceName, startDate, endDateUpdate),
+			Check:  resource.TestCheckResourceAttr(resourcePrefix(resourceName), "end_date", endDateUpdate),
+		},
+		// Import change freeze
+		{
+			ResourceName:      resourcePrefix(resourceName),
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps:             changeFreezeResourceTest,
+	})
+}
+
+func testAccInstanceConfigChangeFreeze(resourceName string, startDate string, endDate string) string {
+	return fmt.Sprintf(`resource "scp_maintenance_change_freeze" %[1]q {
+		applies_to = "all"
+		start_date = %[2]q
+		end_date   = %[3]q
+		reason     = "terraform acceptance test"
+	}`, resourceName, startDate, endDate)
+}
@@ -0,0 +1,109 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	ScheduleAuditDataSourceKey = "scp_maintenance_schedule_audit"
+
+	schemaKeyAudits = "audits"
+)
+
+func maintenanceScheduleAuditDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyScheduleID: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "UUID of the maintenance window to read the audit trail of.",
+		},
+		schemaKeyFromTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validateScheduleTime),
+			Description:      "The earliest time to return audit records from. Format is YYYY-MM-DD or RFC3339, UTC is the default timezone.",
+		},
+		schemaKeyToTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validateScheduleTime),
+			Description:      "The latest time to return audit records from. Format is YYYY-MM-DD or RFC3339, UTC is the default timezone.",
+		},
+		schemaKeyAudits: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: scheduleSchema(),
+			},
+			Description: "The recorded versions of the maintenance window in the time range.",
+		},
+	}
+}
+
+func DataSourceMaintenanceScheduleAudit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maintenance Schedule Audit Data Source. Use this data source to read the audit trail of a maintenance window " +
+			"over a time range, for example to keep a compliance record of each maintenance that happened. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageMaintenanceWindows " +
+			"for more latest, detailed information on the ACS Maintenance Windows API.",
+
+		ReadContext: dataSourceMaintenanceScheduleAuditRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: maintenanceScheduleAuditDataSourceSchema(),
+	}
+}
+
+func dataSourceMaintenanceScheduleAuditRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	scheduleID := d.Get(schemaKeyScheduleID).(string)
+
+	params := parseAuditScheduleParams(d)
+	audits, err := WaitMaintenanceScheduleAudit(ctx, acsClient, stack, scheduleID, params)
+	if err != nil {
+		return diag.Errorf("Error reading audit of maintenance windows schedule (%s): %s", scheduleID, err)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Read %d audit records of maintenance windows schedule (%s)\n", len(audits), scheduleID))
+
+	flattenedAudits, err := flattenSchedules(audits)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyAudits, flattenedAudits); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", scheduleID, d.Get(schemaKeyFromTime).(string), d.Get(schemaKeyToTime).(string)))
+
+	return nil
+}
+
+func parseAuditScheduleParams(d *schema.ResourceData) v2.AuditMaintenanceWindowsScheduleParams {
+	params := v2.AuditMaintenanceWindowsScheduleParams{}
+	if value, ok := d.GetOk(schemaKeyFromTime); ok {
+		fromTime := v2.FromTime(value.(string))
+		params.FromTime = &fromTime
+	}
+	if value, ok := d.GetOk(schemaKeyToTime); ok {
+		toTime := v2.ToTime(value.(string))
+		params.ToTime = &toTime
+	}
+	return params
+}
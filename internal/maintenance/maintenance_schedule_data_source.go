@@ -0,0 +1,75 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ScheduleDataSourceKey = "scp_maintenance_schedule"
+)
+
+func maintenanceScheduleDataSourceSchema() map[string]*schema.Schema {
+	scheduleDataSourceSchema := scheduleSchema()
+	scheduleDataSourceSchema[schemaKeyScheduleID] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      "UUID of the maintenance window to look up.",
+	}
+	return scheduleDataSourceSchema
+}
+
+func DataSourceMaintenanceSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maintenance Schedule Data Source. Use this data source to look up a single maintenance window of a stack " +
+			"by its ID, including its actual start and end times and SFDC tickets. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageMaintenanceWindows " +
+			"for more latest, detailed information on the ACS Maintenance Windows API.",
+
+		ReadContext: dataSourceMaintenanceScheduleRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: maintenanceScheduleDataSourceSchema(),
+	}
+}
+
+func dataSourceMaintenanceScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	scheduleID := d.Get(schemaKeyScheduleID).(string)
+
+	schedule, err := WaitMaintenanceScheduleRead(ctx, acsClient, stack, scheduleID)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return diag.Errorf("Maintenance windows schedule (%s) not found: %s", scheduleID, err)
+		}
+		return diag.Errorf("Error reading maintenance windows schedule (%s): %s", scheduleID, err)
+	}
+
+	flattenedSchedule, err := flattenSchedule(*schedule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenedSchedule {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(scheduleID)
+
+	return nil
+}
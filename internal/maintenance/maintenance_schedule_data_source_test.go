@@ -0,0 +1,47 @@
+package maintenance_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const maintenanceScheduleDataSourceTemplate = `
+data "scp_maintenance_schedule" %[1]q {
+	schedule_id = %[2]q
+}
+
+data "scp_maintenance_schedule_audit" %[1]q {
+	schedule_id = %[2]q
+}
+`
+
+// preCheckSchedule skips the test if no maintenance window of the stack is known to look up
+func preCheckSchedule(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("MAINTENANCE_SCHEDULE_ID") == "" {
+		t.Skip("`MAINTENANCE_SCHEDULE_ID` must be set for maintenance schedule acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudMaintenanceSchedule_DataSource_basic(t *testing.T) {
+	dataSourceName := "schedule"
+	scheduleID := os.Getenv("MAINTENANCE_SCHEDULE_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckSchedule(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(maintenanceScheduleDataSourceTemplate, dataSourceName, scheduleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.scp_maintenance_schedule.%s", dataSourceName), "schedule_id", scheduleID),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_maintenance_schedule.%s", dataSourceName), "duration_seconds"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_maintenance_schedule_audit.%s", dataSourceName), "audits.#"),
+				),
+			},
+		},
+	})
+}
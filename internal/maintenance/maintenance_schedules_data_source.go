@@ -0,0 +1,129 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	SchedulesDataSourceKey = "scp_maintenance_schedules"
+
+	schemaKeyFromTime  = "from_time"
+	schemaKeyToTime    = "to_time"
+	schemaKeyPageSize  = "page_size"
+	schemaKeySchedules = "schedules"
+)
+
+func maintenanceSchedulesDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyFromTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validateScheduleTime),
+			Description:      "The earliest time to return schedules from. Format is YYYY-MM-DD or RFC3339, UTC is the default timezone.",
+		},
+		schemaKeyToTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validateScheduleTime),
+			Description:      "The latest time to return schedules from. Format is YYYY-MM-DD or RFC3339, UTC is the default timezone.",
+		},
+		schemaKeyPageSize: {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description:      "The maximum number of schedules to request per page. All pages are read regardless of the page size.",
+		},
+		schemaKeySchedules: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: scheduleSchema(),
+			},
+			Description: "The maintenance windows schedules in the time range.",
+		},
+	}
+}
+
+func DataSourceMaintenanceSchedules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maintenance Schedules Data Source. Use this data source to list the maintenance windows scheduled for a stack " +
+			"in a time range, for example to hold back deployments during an upcoming maintenance. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageMaintenanceWindows " +
+			"for more latest, detailed information on the ACS Maintenance Windows API.",
+
+		ReadContext: dataSourceMaintenanceSchedulesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: maintenanceSchedulesDataSourceSchema(),
+	}
+}
+
+func dataSourceMaintenanceSchedulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	params := parseListSchedulesParams(d)
+	schedules, err := WaitMaintenanceSchedulesList(ctx, acsClient, stack, params)
+	if err != nil {
+		return diag.Errorf("Error listing maintenance windows schedules: %s", err)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Read %d maintenance windows schedules\n", len(schedules)))
+
+	flattenedSchedules, err := flattenSchedules(schedules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeySchedules, flattenedSchedules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", stack, d.Get(schemaKeyFromTime).(string), d.Get(schemaKeyToTime).(string)))
+
+	return nil
+}
+
+func parseListSchedulesParams(d *schema.ResourceData) v2.ListMaintenanceWindowsSchedulesParams {
+	params := v2.ListMaintenanceWindowsSchedulesParams{}
+	if value, ok := d.GetOk(schemaKeyFromTime); ok {
+		fromTime := v2.FromTime(value.(string))
+		params.FromTime = &fromTime
+	}
+	if value, ok := d.GetOk(schemaKeyToTime); ok {
+		toTime := v2.ToTime(value.(string))
+		params.ToTime = &toTime
+	}
+	if value, ok := d.GetOk(schemaKeyPageSize); ok {
+		count := v2.Count(value.(int))
+		params.Count = &count
+	}
+	return params
+}
+
+// validateScheduleTime validates that a time filter of the maintenance windows schedules is in the YYYY-MM-DD or RFC3339 format
+func validateScheduleTime(i interface{}, k string) (warnings []string, errs []error) {
+	value, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.Parse(time.DateOnly, value); err == nil {
+		return nil, nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("expected %s to be a date in the YYYY-MM-DD format or a time in the RFC3339 format, got %s", k, value)}
+}
@@ -0,0 +1,34 @@
+package maintenance_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const maintenanceSchedulesDataSourceTemplate = `
+data "scp_maintenance_schedules" %[1]q {
+	from_time = %[2]q
+	to_time   = %[3]q
+	page_size = 1
+}
+`
+
+func TestAcc_SplunkCloudMaintenanceSchedules_DataSource_basic(t *testing.T) {
+	dataSourceName := "upcoming"
+	fromTime := time.Now().UTC().Format(time.DateOnly)
+	toTime := time.Now().UTC().AddDate(0, 3, 0).Format(time.DateOnly)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(maintenanceSchedulesDataSourceTemplate, dataSourceName, fromTime, toTime),
+				Check:  resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_maintenance_schedules.%s", dataSourceName), "schedules.#"),
+			},
+		},
+	})
+}
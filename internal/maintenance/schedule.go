@@ -0,0 +1,297 @@
+package maintenance
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+)
+
+const (
+	schemaKeyScheduleID              = "schedule_id"
+	schemaKeyMwType                  = "mw_type"
+	schemaKeyStatus                  = "status"
+	schemaKeyRequestedEntity         = "requested_entity"
+	schemaKeyRequestedUser           = "requested_user"
+	schemaKeyScheduleStartTimestamp  = "schedule_start_timestamp"
+	schemaKeyScheduleEndTimestamp    = "schedule_end_timestamp"
+	schemaKeyMaintenanceStartedAt    = "maintenance_started_at"
+	schemaKeyMaintenanceEndedAt      = "maintenance_ended_at"
+	schemaKeyLastModified            = "last_modified_timestamp"
+	schemaKeyDuration                = "duration"
+	schemaKeyDurationSeconds         = "duration_seconds"
+	schemaKeyExtendedDuration        = "extended_duration"
+	schemaKeyExtendedDurationSeconds = "extended_duration_seconds"
+	schemaKeyZeroDowntime            = "zero_downtime"
+	schemaKeyOperations              = "operations"
+	schemaKeyDescription             = "description"
+	schemaKeyStartTime               = "start_time"
+	schemaKeyEndTime                 = "end_time"
+	schemaKeySFDCTickets             = "sfdc_tickets"
+	schemaKeyNotes                   = "notes"
+	schemaKeyMetadata                = "metadata"
+)
+
+// scheduleSchema returns the computed attributes of a maintenance windows schedule
+func scheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyScheduleID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "UUID of the maintenance window.",
+		},
+		schemaKeyMwType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of upgrade performed in the maintenance window.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the maintenance window schedule.",
+		},
+		schemaKeyRequestedEntity: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The entity which requested the maintenance window, either the customer or Splunk.",
+		},
+		schemaKeyRequestedUser: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The user who requested the maintenance window.",
+		},
+		schemaKeyScheduleStartTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the maintenance window is scheduled to begin. Format is RFC3339.",
+		},
+		schemaKeyScheduleEndTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the maintenance window is scheduled to end. Format is RFC3339.",
+		},
+		schemaKeyMaintenanceStartedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the maintenance window actually started. Empty until the window begins. Format is RFC3339.",
+		},
+		schemaKeyMaintenanceEndedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the maintenance window actually ended. Empty until the window ends. Format is RFC3339.",
+		},
+		schemaKeyLastModified: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the maintenance window was last modified. Format is RFC3339.",
+		},
+		schemaKeyDuration: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The duration of the maintenance window, e.g. 4h0m0s.",
+		},
+		schemaKeyDurationSeconds: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The duration of the maintenance window in seconds.",
+		},
+		schemaKeyExtendedDuration: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The duration of the maintenance window schedule extension, e.g. 1h0m0s.",
+		},
+		schemaKeyExtendedDurationSeconds: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The duration of the maintenance window schedule extension in seconds, 0 if the window was not extended.",
+		},
+		schemaKeySFDCTickets: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "SFDC tickets associated with any operation of the maintenance window, sorted and without duplicates.",
+		},
+		schemaKeyZeroDowntime: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the maintenance window will have no impact on the uptime of the stack.",
+		},
+		schemaKeyOperations: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: operationSchema(),
+			},
+			Description: "The operations being performed in the maintenance window.",
+		},
+	}
+}
+
+// operationSchema returns the computed attributes of an operation of a maintenance windows schedule
+func operationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyDescription: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the operation.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the operation.",
+		},
+		schemaKeyZeroDowntime: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the operation will have no impact on the uptime of the stack.",
+		},
+		schemaKeyStartTime: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the operation started. Format is RFC3339.",
+		},
+		schemaKeyEndTime: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time at which the operation ended. Format is RFC3339.",
+		},
+		schemaKeySFDCTickets: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "SFDC tickets associated with the operation.",
+		},
+		schemaKeyNotes: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Notes for the customer.",
+		},
+		schemaKeyMetadata: {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Metadata about the operation being performed, e.g. AppName or TargetVersion.",
+		},
+	}
+}
+
+// flattenSchedule converts a maintenance windows schedule to the attributes of the schedule schema
+func flattenSchedule(schedule v2.MaintenanceWindowsSchedule) (map[string]interface{}, error) {
+	operations := make([]map[string]interface{}, 0, len(schedule.Operations))
+	tickets := make([]string, 0)
+	for _, operation := range schedule.Operations {
+		operations = append(operations, flattenOperation(operation))
+		tickets = append(tickets, stringSliceValue(operation.SFDCTickets)...)
+	}
+	sort.Strings(tickets)
+	tickets = slices.Compact(tickets)
+
+	durationSeconds, err := ParseDurationSeconds(schedule.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration of maintenance windows schedule (%s): %w", schedule.ScheduleId, err)
+	}
+	extendedDurationSeconds, err := ParseDurationSeconds(stringValue(schedule.ExtendedDuration))
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended duration of maintenance windows schedule (%s): %w", schedule.ScheduleId, err)
+	}
+
+	return map[string]interface{}{
+		schemaKeyScheduleID:              schedule.ScheduleId,
+		schemaKeyMwType:                  schedule.MwType,
+		schemaKeyStatus:                  schedule.Status,
+		schemaKeyRequestedEntity:         schedule.RequestedEntity,
+		schemaKeyRequestedUser:           stringValue(schedule.RequestedUser),
+		schemaKeyScheduleStartTimestamp:  FormatTimestamp(&schedule.ScheduleStartTimestamp),
+		schemaKeyScheduleEndTimestamp:    FormatTimestamp(&schedule.ScheduleEndTimestamp),
+		schemaKeyMaintenanceStartedAt:    FormatTimestamp(schedule.MaintenanceStartedAt),
+		schemaKeyMaintenanceEndedAt:      FormatTimestamp(schedule.MaintenanceEndedAt),
+		schemaKeyLastModified:            FormatTimestamp(&schedule.LastModifiedTimestamp),
+		schemaKeyDuration:                schedule.Duration,
+		schemaKeyDurationSeconds:         durationSeconds,
+		schemaKeyExtendedDuration:        stringValue(schedule.ExtendedDuration),
+		schemaKeyExtendedDurationSeconds: extendedDurationSeconds,
+		schemaKeySFDCTickets:             tickets,
+		schemaKeyZeroDowntime:            schedule.ZeroDowntime,
+		schemaKeyOperations:              operations,
+	}, nil
+}
+
+// flattenSchedules converts maintenance windows schedules to a list of attributes of the schedule schema
+func flattenSchedules(schedules []v2.MaintenanceWindowsSchedule) ([]map[string]interface{}, error) {
+	flattenedSchedules := make([]map[string]interface{}, 0, len(schedules))
+	for _, schedule := range schedules {
+		flattenedSchedule, err := flattenSchedule(schedule)
+		if err != nil {
+			return nil, err
+		}
+		flattenedSchedules = append(flattenedSchedules, flattenedSchedule)
+	}
+	return flattenedSchedules, nil
+}
+
+// flattenOperation converts an operation of a maintenance windows schedule to the attributes of the operation schema
+func flattenOperation(operation v2.MaintenanceWindowsOperation) map[string]interface{} {
+	metadata := map[string]string{}
+	if operation.Metadata != nil {
+		for key, value := range *operation.Metadata {
+			metadata[key] = fmt.Sprint(value)
+		}
+	}
+
+	return map[string]interface{}{
+		schemaKeyDescription:  operation.OperationDescription,
+		schemaKeyStatus:       operation.OperationStatus,
+		schemaKeyZeroDowntime: operation.ZeroDowntime,
+		schemaKeyStartTime:    FormatTimestamp(operation.StartTime),
+		schemaKeyEndTime:      FormatTimestamp(operation.EndTime),
+		schemaKeySFDCTickets:  stringSliceValue(operation.SFDCTickets),
+		schemaKeyNotes:        stringSliceValue(operation.Notes),
+		schemaKeyMetadata:     metadata,
+	}
+}
+
+// ParseDurationSeconds returns the number of whole seconds of a Go duration string, e.g. 4h30m, or 0 if the duration is empty
+func ParseDurationSeconds(duration string) (int, error) {
+	if duration == "" {
+		return 0, nil
+	}
+	parsed, err := time.ParseDuration(duration)
+	if err != nil {
+		return 0, err
+	}
+	return int(parsed.Seconds()), nil
+}
+
+// FormatTimestamp returns the timestamp in RFC3339 format in UTC, or an empty string if the timestamp is not set
+func FormatTimestamp(timestamp *time.Time) string {
+	if timestamp == nil || timestamp.IsZero() {
+		return ""
+	}
+	return timestamp.UTC().Format(time.RFC3339)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func stringSliceValue(values *[]string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return *values
+}
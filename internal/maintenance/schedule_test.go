@@ -0,0 +1,29 @@
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/splunk/terraform-provider-scp/internal/maintenance"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseDurationSeconds(t *testing.T) {
+	seconds, err := maintenance.ParseDurationSeconds("4h30m")
+	assert.NoError(t, err)
+	assert.Equal(t, 16200, seconds)
+
+	seconds, err = maintenance.ParseDurationSeconds("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, seconds)
+
+	_, err = maintenance.ParseDurationSeconds("4 hours")
+	assert.Error(t, err)
+}
+
+func Test_FormatTimestamp(t *testing.T) {
+	timestamp := time.Date(2024, 3, 10, 4, 0, 0, 0, time.FixedZone("PDT", -7*60*60))
+	assert.Equal(t, "2024-03-10T11:00:00Z", maintenance.FormatTimestamp(&timestamp))
+	assert.Equal(t, "", maintenance.FormatTimestamp(nil))
+	assert.Equal(t, "", maintenance.FormatTimestamp(&time.Time{}))
+}
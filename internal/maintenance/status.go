@@ -0,0 +1,287 @@
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// ChangeFreezeMergeFunc returns the customer initiated change freezes to send given the current change freezes of the stack
+type ChangeFreezeMergeFunc func(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error)
+
+// MaintenancePreferencesStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the maintenance windows preferences
+func MaintenancePreferencesStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeMaintenanceWindowsPreferences(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var preferences v2.MaintenanceWindowsPreferencesResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &preferences); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &preferences, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// ChangeFreezeStatusApply returns StateRefreshFunc that reads the current maintenance windows preferences, merges the change
+// freezes with the given function and makes PUT request with the record version that was read. A version conflict is
+// returned as pending so that the next refresh starts over from a fresh read.
+func ChangeFreezeStatusApply(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, merge ChangeFreezeMergeFunc) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rawPreferences, statusText, err := MaintenancePreferencesStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return rawPreferences, statusText, err
+		}
+		preferences := rawPreferences.(*v2.MaintenanceWindowsPreferencesResponse)
+
+		freezes, err := merge(preferences.ChangeFreezes.CustomerInitiatedFreezes)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+
+		recordVersion := preferences.RecordVersion
+		updateBody := v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody{
+			ChangeFreezes: v2.MaintenanceWindowsChangeFreezeRequest{CustomerInitiatedFreezes: freezes},
+			RecordVersion: &recordVersion,
+		}
+		resp, err := acsClient.UpdateMaintenanceWindowsPreferences(ctx, stack, updateBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, TargetStatusResourceChange, PendingStatusUpdate)
+	}
+}
+
+// ChangeFreezeStatusVerify returns a StateRefreshFunc that makes a GET request and checks to see if the change freeze
+// with the given ID matches the given change freeze, or is absent if present is false
+func ChangeFreezeStatusVerify(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, id string, freeze v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, present bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		rawPreferences, statusText, err := MaintenancePreferencesStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return rawPreferences, statusText, err
+		}
+		preferences := rawPreferences.(*v2.MaintenanceWindowsPreferencesResponse)
+
+		current := FindChangeFreeze(preferences.ChangeFreezes.CustomerInitiatedFreezes, id)
+		if present && (current == nil || !IsChangeFreezeEqual(*current, freeze)) {
+			return preferences, statusText, nil
+		}
+		if !present && current != nil {
+			return preferences, statusText, nil
+		}
+		return preferences, status.UpdatedStatus, nil
+	}
+}
+
+// ChangeFreezeStatusVerifyCreate returns a StateRefreshFunc that makes a GET request and checks to see if a change freeze
+// matching the given change freeze that is not one of the existing change freezes has been added
+func ChangeFreezeStatusVerifyCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, freeze v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, existingIDs []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		rawPreferences, statusText, err := MaintenancePreferencesStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return rawPreferences, statusText, err
+		}
+		preferences := rawPreferences.(*v2.MaintenanceWindowsPreferencesResponse)
+
+		for _, current := range preferences.ChangeFreezes.CustomerInitiatedFreezes {
+			if !containsID(existingIDs, current.Id) && IsChangeFreezeEqual(current, freeze) {
+				created := current
+				return &created, status.UpdatedStatus, nil
+			}
+		}
+		return preferences, statusText, nil
+	}
+}
+
+// MergeChangeFreeze returns the current change freezes as requests with the given change freeze replacing the change
+// freeze of the same ID, or appended if the given change freeze has no ID yet
+func MergeChangeFreeze(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse, freeze v2.MaintenanceWindowsCustomerInitiatedFreezeRequest) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+	freezes := make([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, 0, len(current)+1)
+	found := false
+	for _, currentFreeze := range current {
+		if freeze.Id != nil && currentFreeze.Id == *freeze.Id {
+			freezes = append(freezes, freeze)
+			found = true
+			continue
+		}
+		freezes = append(freezes, ToChangeFreezeRequest(currentFreeze))
+	}
+
+	if freeze.Id == nil {
+		freezes = append(freezes, freeze)
+	} else if !found {
+		return nil, fmt.Errorf("change freeze (%s) not found", *freeze.Id)
+	}
+	return freezes, nil
+}
+
+// RemoveChangeFreeze returns the current change freezes as requests without the change freeze of the given ID
+func RemoveChangeFreeze(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse, id string) []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest {
+	freezes := make([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, 0, len(current))
+	for _, currentFreeze := range current {
+		if currentFreeze.Id != id {
+			freezes = append(freezes, ToChangeFreezeRequest(currentFreeze))
+		}
+	}
+	return freezes
+}
+
+// FindChangeFreeze returns the change freeze with the given ID or nil if there is none
+func FindChangeFreeze(freezes []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse, id string) *v2.MaintenanceWindowsCustomerInitiatedFreezeResponse {
+	for _, freeze := range freezes {
+		if freeze.Id == id {
+			found := freeze
+			return &found
+		}
+	}
+	return nil
+}
+
+// ToChangeFreezeRequest converts a change freeze of the maintenance windows preferences to a change freeze request keeping its ID
+func ToChangeFreezeRequest(freeze v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) v2.MaintenanceWindowsCustomerInitiatedFreezeRequest {
+	id := freeze.Id
+	return v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+		Id:        &id,
+		AppliesTo: freeze.AppliesTo,
+		StartDate: freeze.StartDate,
+		EndDate:   freeze.EndDate,
+		Reason:    freeze.Reason,
+	}
+}
+
+// IsChangeFreezeEqual returns true if the change freeze has the same scope, dates and reason as the change freeze request
+func IsChangeFreezeEqual(freeze v2.MaintenanceWindowsCustomerInitiatedFreezeResponse, request v2.MaintenanceWindowsCustomerInitiatedFreezeRequest) bool {
+	return freeze.AppliesTo == request.AppliesTo &&
+		freeze.StartDate == request.StartDate &&
+		freeze.EndDate == request.EndDate &&
+		freeze.Reason == request.Reason
+}
+
+// ChangeFreezeIDs returns the IDs of the change freezes
+func ChangeFreezeIDs(freezes []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) []string {
+	ids := make([]string, 0, len(freezes))
+	for _, freeze := range freezes {
+		ids = append(ids, freeze.Id)
+	}
+	return ids
+}
+
+func containsID(ids []string, id string) bool {
+	for _, existingID := range ids {
+		if existingID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// MaintenanceSchedulesStatusList returns StateRefreshFunc that makes GET request, checks if request was successful, and returns a page of maintenance windows schedules
+func MaintenanceSchedulesStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListMaintenanceWindowsSchedulesParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListMaintenanceWindowsSchedules(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var schedules v2.MaintenanceWindowsResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &schedules); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &schedules, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// MaintenanceScheduleStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the maintenance windows schedule
+func MaintenanceScheduleStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, scheduleID string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeMaintenanceWindowsSchedule(ctx, stack, v2.ScheduleID(scheduleID))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var schedule v2.MaintenanceWindowsSchedule
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &schedule); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &schedule, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// MaintenanceScheduleStatusAudit returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the audit trail of the maintenance windows schedule
+func MaintenanceScheduleStatusAudit(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, scheduleID string, params v2.AuditMaintenanceWindowsScheduleParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.AuditMaintenanceWindowsSchedule(ctx, stack, v2.ScheduleID(scheduleID), &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		audit := v2.MaintenanceWindowsAuditResponse{Audits: []v2.MaintenanceWindowsSchedule{}}
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &audit); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &audit, http.StatusText(resp.StatusCode), nil
+	}
+}
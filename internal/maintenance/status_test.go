@@ -0,0 +1,197 @@
+package maintenance_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/maintenance"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ChangeFreezeStatusApply(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	current := []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{genChangeFreeze(mockFreezeID, mockFreeze)}
+	merge := func(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+		return maintenance.MergeChangeFreeze(current, mockNewFreeze)
+	}
+
+	t.Run("with record version of the read", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, current), nil).Once()
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), genUpdateBody(3, mockFreezeID)).Return(genRawResp(http.StatusOK, ""), nil).Once()
+		_, statusText, err := maintenance.ChangeFreezeStatusApply(context.TODO(), client, mockStack, merge)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+
+	t.Run("with version conflict", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, current), nil).Once()
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), genUpdateBody(3, mockFreezeID)).Return(genRawResp(http.StatusConflict, ""), nil).Once()
+		_, statusText, err := maintenance.ChangeFreezeStatusApply(context.TODO(), client, mockStack, merge)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusConflict), statusText)
+	})
+
+	t.Run("with merge error", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, nil), nil).Once()
+		id := mockFreezeID
+		freeze := mockFreeze
+		freeze.Id = &id
+		_, _, err := maintenance.ChangeFreezeStatusApply(context.TODO(), client, mockStack, func(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+			return maintenance.MergeChangeFreeze(current, freeze)
+		})()
+		assert.ErrorContains(t, err, "not found")
+	})
+}
+
+func Test_ChangeFreezeStatusVerifyCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	existing := genChangeFreeze(mockFreezeID, mockNewFreeze)
+	created := genChangeFreeze("new-freeze-id", mockNewFreeze)
+
+	t.Run("with matching change freeze that already existed", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 4, []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{existing}), nil).Once()
+		_, statusText, err := maintenance.ChangeFreezeStatusVerifyCreate(context.TODO(), client, mockStack, mockNewFreeze, []string{mockFreezeID})()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+
+	t.Run("with new matching change freeze", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 4, []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{existing, created}), nil).Once()
+		freeze, statusText, err := maintenance.ChangeFreezeStatusVerifyCreate(context.TODO(), client, mockStack, mockNewFreeze, []string{mockFreezeID})()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, statusText)
+		assert.Equal(t, &created, freeze)
+	})
+}
+
+func Test_MergeChangeFreeze(t *testing.T) {
+	current := []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{genChangeFreeze(mockFreezeID, mockFreeze), genChangeFreeze("other-freeze-id", mockNewFreeze)}
+
+	t.Run("with new change freeze", func(t *testing.T) {
+		freezes, err := maintenance.MergeChangeFreeze(current, mockNewFreeze)
+		assert.NoError(t, err)
+		assert.Equal(t, []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+			maintenance.ToChangeFreezeRequest(current[0]), maintenance.ToChangeFreezeRequest(current[1]), mockNewFreeze,
+		}, freezes)
+	})
+
+	t.Run("with existing change freeze", func(t *testing.T) {
+		id := mockFreezeID
+		updated := mockNewFreeze
+		updated.Id = &id
+		freezes, err := maintenance.MergeChangeFreeze(current, updated)
+		assert.NoError(t, err)
+		assert.Equal(t, []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{updated, maintenance.ToChangeFreezeRequest(current[1])}, freezes)
+	})
+
+	t.Run("with missing change freeze", func(t *testing.T) {
+		id := "missing-freeze-id"
+		missing := mockNewFreeze
+		missing.Id = &id
+		_, err := maintenance.MergeChangeFreeze(current, missing)
+		assert.Error(t, err)
+	})
+}
+
+func Test_RemoveChangeFreeze(t *testing.T) {
+	current := []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{genChangeFreeze(mockFreezeID, mockFreeze), genChangeFreeze("other-freeze-id", mockNewFreeze)}
+	assert.Equal(t, []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{maintenance.ToChangeFreezeRequest(current[1])}, maintenance.RemoveChangeFreeze(current, mockFreezeID))
+	assert.Len(t, maintenance.RemoveChangeFreeze(current, "missing-freeze-id"), 2)
+}
+
+func genChangeFreeze(id string, freeze v2.MaintenanceWindowsCustomerInitiatedFreezeRequest) v2.MaintenanceWindowsCustomerInitiatedFreezeResponse {
+	timestamp := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	return v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{
+		Id:                    id,
+		AppliesTo:             freeze.AppliesTo,
+		StartDate:             freeze.StartDate,
+		EndDate:               freeze.EndDate,
+		Reason:                freeze.Reason,
+		CreatedTimestamp:      timestamp,
+		LastModifiedTimestamp: timestamp,
+		Tickets:               []string{"CO-12345"},
+	}
+}
+
+// genUpdateBody returns the update request of the mock change freeze with the given ID and the new mock change freeze
+func genUpdateBody(recordVersion int, id string) v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody {
+	freeze := mockFreeze
+	freeze.Id = &id
+	return v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody{
+		ChangeFreezes: v2.MaintenanceWindowsChangeFreezeRequest{
+			CustomerInitiatedFreezes: []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{freeze, mockNewFreeze},
+		},
+		RecordVersion: &recordVersion,
+	}
+}
+
+func genPreferencesResp(statusCode int, recordVersion int, freezes []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) *http.Response {
+	if statusCode != http.StatusOK {
+		b, _ := json.Marshal(&v2.Error{
+			Code:    http.StatusText(statusCode),
+			Message: http.StatusText(statusCode),
+		})
+		return genRawResp(statusCode, string(b))
+	}
+
+	if freezes == nil {
+		freezes = []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{}
+	}
+	b, _ := json.Marshal(v2.MaintenanceWindowsPreferencesResponse{
+		ChangeFreezes: v2.MaintenanceWindowsChangeFreezeResponse{CustomerInitiatedFreezes: freezes},
+		RecordVersion: recordVersion,
+	})
+	return genRawResp(statusCode, string(b))
+}
+
+func genSchedule(id string) v2.MaintenanceWindowsSchedule {
+	startTime := time.Date(2024, 3, 10, 2, 0, 0, 0, time.UTC)
+	tickets := []string{"CO-12345"}
+	return v2.MaintenanceWindowsSchedule{
+		ScheduleId:             id,
+		Duration:               "4h0m0s",
+		MwType:                 "upgrade",
+		RequestedEntity:        "splunk",
+		Status:                 "scheduled",
+		ScheduleStartTimestamp: startTime,
+		ScheduleEndTimestamp:   startTime.Add(4 * time.Hour),
+		LastModifiedTimestamp:  startTime.AddDate(0, 0, -7),
+		Operations: []v2.MaintenanceWindowsOperation{{
+			OperationDescription: "Splunk Cloud Platform upgrade",
+			OperationStatus:      "scheduled",
+			SFDCTickets:          &tickets,
+		}},
+	}
+}
+
+func genSchedulesResp(statusCode int, schedules []v2.MaintenanceWindowsSchedule, nextLink string) *http.Response {
+	if statusCode != http.StatusOK {
+		return genPreferencesResp(statusCode, 0, nil)
+	}
+	b, _ := json.Marshal(v2.MaintenanceWindowsResponse{Schedules: schedules, NextLink: nextLink})
+	return genRawResp(statusCode, string(b))
+}
+
+func genScheduleResp(statusCode int, body interface{}) *http.Response {
+	if statusCode != http.StatusOK {
+		return genPreferencesResp(statusCode, 0, nil)
+	}
+	b, _ := json.Marshal(body)
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
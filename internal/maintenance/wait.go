@@ -0,0 +1,144 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// Maintenance windows preferences are either updated synchronously (200) or accepted (202)
+	TargetStatusResourceChange = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusAccepted)}
+
+	// A record version conflict means another client updated the preferences since they were read, the update is retried with a fresh read
+	PendingStatusUpdate = []string{http.StatusText(http.StatusTooManyRequests), http.StatusText(http.StatusFailedDependency), http.StatusText(http.StatusConflict)}
+
+	// Change freezes are read back until the update has been applied
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitMaintenancePreferencesRead Handles retry logic for GET requests reading the maintenance windows preferences
+func WaitMaintenancePreferencesRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.MaintenanceWindowsPreferencesResponse, error) {
+	waitMaintenancePreferencesRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, MaintenancePreferencesStatusRead(ctx, acsClient, stack))
+
+	output, err := waitMaintenancePreferencesRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading maintenance windows preferences: %s", err))
+		return nil, err
+	}
+	preferences := output.(*v2.MaintenanceWindowsPreferencesResponse)
+
+	return preferences, nil
+}
+
+// WaitChangeFreezeApply Handles retry logic for PUT requests updating the change freezes of the maintenance windows preferences
+// for the create, update and delete lifecycle functions
+func WaitChangeFreezeApply(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, merge ChangeFreezeMergeFunc) error {
+	waitChangeFreezeApplyAccepted := wait.GenerateWriteStateChangeConf(ChangeFreezeStatusApply(ctx, acsClient, stack, merge))
+	waitChangeFreezeApplyAccepted.Pending = PendingStatusUpdate
+	waitChangeFreezeApplyAccepted.Target = TargetStatusResourceChange
+
+	rawResp, err := waitChangeFreezeApplyAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for change freezes to be updated: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for change freezes: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for change freezes: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyChangeFreezeCreate waits until a change freeze matching the given change freeze has been added and returns it
+func WaitVerifyChangeFreezeCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, freeze v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, existingIDs []string) (*v2.MaintenanceWindowsCustomerInitiatedFreezeResponse, error) {
+	waitChangeFreezeCreated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, ChangeFreezeStatusVerifyCreate(ctx, acsClient, stack, freeze, existingIDs))
+
+	output, err := waitChangeFreezeCreated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for change freeze to be created: %s", err))
+		return nil, err
+	}
+	created := output.(*v2.MaintenanceWindowsCustomerInitiatedFreezeResponse)
+
+	return created, nil
+}
+
+// WaitVerifyChangeFreezeUpdate waits until the change freeze with the given ID matches the given change freeze, or is absent if present is false
+func WaitVerifyChangeFreezeUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, id string, freeze v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, present bool) error {
+	waitChangeFreezeUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, ChangeFreezeStatusVerify(ctx, acsClient, stack, id, freeze, present))
+
+	_, err := waitChangeFreezeUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for change freeze (%s) to be updated: %s", id, err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitMaintenanceSchedulesList Handles retry logic for GET requests listing maintenance windows schedules and follows the
+// nextLink of each page until all schedules in the time range have been read
+func WaitMaintenanceSchedulesList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListMaintenanceWindowsSchedulesParams) ([]v2.MaintenanceWindowsSchedule, error) {
+	schedules := make([]v2.MaintenanceWindowsSchedule, 0)
+	visitedLinks := map[string]bool{}
+	for {
+		waitMaintenanceSchedulesList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, MaintenanceSchedulesStatusList(ctx, acsClient, stack, params))
+
+		output, err := waitMaintenanceSchedulesList.WaitForStateContext(ctx)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error listing maintenance windows schedules: %s", err))
+			return nil, err
+		}
+		page := output.(*v2.MaintenanceWindowsResponse)
+		schedules = append(schedules, page.Schedules...)
+
+		if page.NextLink == "" {
+			return schedules, nil
+		}
+		// a nextLink that was already followed would never end the listing
+		if visitedLinks[page.NextLink] {
+			return nil, fmt.Errorf("nextLink (%s) of maintenance windows schedules was already read", page.NextLink)
+		}
+		visitedLinks[page.NextLink] = true
+
+		nextLink := v2.NextLink(page.NextLink)
+		params.NextLink = &nextLink
+	}
+}
+
+// WaitMaintenanceScheduleRead Handles retry logic for GET requests reading a maintenance windows schedule
+func WaitMaintenanceScheduleRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, scheduleID string) (*v2.MaintenanceWindowsSchedule, error) {
+	waitMaintenanceScheduleRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, MaintenanceScheduleStatusRead(ctx, acsClient, stack, scheduleID))
+
+	output, err := waitMaintenanceScheduleRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading maintenance windows schedule (%s): %s", scheduleID, err))
+		return nil, err
+	}
+	schedule := output.(*v2.MaintenanceWindowsSchedule)
+
+	return schedule, nil
+}
+
+// WaitMaintenanceScheduleAudit Handles retry logic for GET requests reading the audit trail of a maintenance windows schedule
+func WaitMaintenanceScheduleAudit(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, scheduleID string, params v2.AuditMaintenanceWindowsScheduleParams) ([]v2.MaintenanceWindowsSchedule, error) {
+	waitMaintenanceScheduleAudit := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, MaintenanceScheduleStatusAudit(ctx, acsClient, stack, scheduleID, params))
+
+	output, err := waitMaintenanceScheduleAudit.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading audit of maintenance windows schedule (%s): %s", scheduleID, err))
+		return nil, err
+	}
+	audit := output.(*v2.MaintenanceWindowsAuditResponse)
+
+	return audit.Audits, nil
+}
@@ -0,0 +1,284 @@
+package maintenance_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/maintenance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack    = "mock-stack"
+	mockFreezeID = "9a3b4c1e-0000-4000-8000-000000000001"
+)
+
+var (
+	unexpectedStatusCodes = []int{400, 401, 403, 404, 501, 503}
+
+	mockFreeze = v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+		AppliesTo: "all",
+		StartDate: "2024/03/25",
+		EndDate:   "2024/04/05",
+		Reason:    "quarter end",
+	}
+	mockNewFreeze = v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+		AppliesTo: "all",
+		StartDate: "2024/06/24",
+		EndDate:   "2024/07/05",
+		Reason:    "quarter end",
+	}
+)
+
+func Test_WaitMaintenancePreferencesRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	freezes := []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{genChangeFreeze(mockFreezeID, mockFreeze)}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		preferences, err := maintenance.WaitMaintenancePreferencesRead(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, preferences)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, freezes), nil).Once()
+		preferences, err := maintenance.WaitMaintenancePreferencesRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, preferences.RecordVersion)
+		assert.Equal(t, freezes, preferences.ChangeFreezes.CustomerInitiatedFreezes)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusTooManyRequests, 0, nil), nil).Once()
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, freezes), nil).Once()
+		preferences, err := maintenance.WaitMaintenancePreferencesRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, freezes, preferences.ChangeFreezes.CustomerInitiatedFreezes)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(statusCode, 0, nil), nil).Once()
+				preferences, err := maintenance.WaitMaintenancePreferencesRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, preferences)
+			})
+		}
+	})
+}
+
+func Test_WaitChangeFreezeApply(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	merge := func(current []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+		return maintenance.MergeChangeFreeze(current, mockNewFreeze)
+	}
+	current := []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{genChangeFreeze(mockFreezeID, mockFreeze)}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, current), nil).Once()
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), genUpdateBody(3, mockFreezeID)).Return(nil, errors.New("some error")).Once()
+		err := maintenance.WaitChangeFreezeApply(context.TODO(), client, mockStack, merge)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, current), nil).Once()
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), genUpdateBody(3, mockFreezeID)).Return(genRawResp(http.StatusOK, ""), nil).Once()
+		err := maintenance.WaitChangeFreezeApply(context.TODO(), client, mockStack, merge)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with version conflict retried with a fresh read", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, current), nil).Once()
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), genUpdateBody(3, mockFreezeID)).Return(genRawResp(http.StatusConflict, ""), nil).Once()
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 4, current), nil).Once()
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), genUpdateBody(4, mockFreezeID)).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := maintenance.WaitChangeFreezeApply(context.TODO(), client, mockStack, merge)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 3, current), nil).Once()
+				client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), genUpdateBody(3, mockFreezeID)).Return(genRawResp(statusCode, ""), nil).Once()
+				err := maintenance.WaitChangeFreezeApply(context.TODO(), client, mockStack, merge)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyChangeFreezeCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	existing := genChangeFreeze(mockFreezeID, mockFreeze)
+	created := genChangeFreeze("new-freeze-id", mockNewFreeze)
+
+	t.Run("with change freeze added", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 4, []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{existing}), nil).Once()
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 4, []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{existing, created}), nil).Once()
+		freeze, err := maintenance.WaitVerifyChangeFreezeCreate(context.TODO(), client, mockStack, mockNewFreeze, []string{mockFreezeID})
+		assert.NoError(t, err)
+		assert.Equal(t, created.Id, freeze.Id)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(statusCode, 0, nil), nil).Once()
+				freeze, err := maintenance.WaitVerifyChangeFreezeCreate(context.TODO(), client, mockStack, mockNewFreeze, []string{mockFreezeID})
+				assert.Error(t, err)
+				assert.Nil(t, freeze)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyChangeFreezeUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	existing := genChangeFreeze(mockFreezeID, mockFreeze)
+	updated := genChangeFreeze(mockFreezeID, mockNewFreeze)
+
+	t.Run("with change freeze updated", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 4, []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{existing}), nil).Once()
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 5, []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{updated}), nil).Once()
+		err := maintenance.WaitVerifyChangeFreezeUpdate(context.TODO(), client, mockStack, mockFreezeID, mockNewFreeze, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with change freeze removed", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK, 5, nil), nil).Once()
+		err := maintenance.WaitVerifyChangeFreezeUpdate(context.TODO(), client, mockStack, mockFreezeID, v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{}, false)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_WaitMaintenanceSchedulesList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	fromTime := v2.FromTime("2024-03-01")
+	params := v2.ListMaintenanceWindowsSchedulesParams{FromTime: &fromTime}
+	nextLink := v2.NextLink("page-2")
+	paramsPage2 := v2.ListMaintenanceWindowsSchedulesParams{FromTime: &fromTime, NextLink: &nextLink}
+	schedules := []v2.MaintenanceWindowsSchedule{genSchedule("schedule-1"), genSchedule("schedule-2")}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &params).Return(nil, errors.New("some error")).Once()
+		listed, err := maintenance.WaitMaintenanceSchedulesList(context.TODO(), client, mockStack, params)
+		assert.Error(t, err)
+		assert.Nil(t, listed)
+	})
+
+	t.Run("with multiple pages", func(t *testing.T) {
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &params).Return(genSchedulesResp(http.StatusOK, schedules[:1], "page-2"), nil).Once()
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &paramsPage2).Return(genSchedulesResp(http.StatusOK, schedules[1:], ""), nil).Once()
+		listed, err := maintenance.WaitMaintenanceSchedulesList(context.TODO(), client, mockStack, params)
+		assert.NoError(t, err)
+		assert.Equal(t, schedules, listed)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &params).Return(genSchedulesResp(http.StatusTooManyRequests, nil, ""), nil).Once()
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &params).Return(genSchedulesResp(http.StatusOK, schedules, ""), nil).Once()
+		listed, err := maintenance.WaitMaintenanceSchedulesList(context.TODO(), client, mockStack, params)
+		assert.NoError(t, err)
+		assert.Equal(t, schedules, listed)
+	})
+
+	t.Run("with repeated nextLink", func(t *testing.T) {
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &params).Return(genSchedulesResp(http.StatusOK, schedules[:1], "page-2"), nil).Once()
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &paramsPage2).Return(genSchedulesResp(http.StatusOK, schedules[1:], "page-2"), nil).Once()
+		listed, err := maintenance.WaitMaintenanceSchedulesList(context.TODO(), client, mockStack, params)
+		assert.Error(t, err)
+		assert.Nil(t, listed)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), &params).Return(genSchedulesResp(statusCode, nil, ""), nil).Once()
+				listed, err := maintenance.WaitMaintenanceSchedulesList(context.TODO(), client, mockStack, params)
+				assert.Error(t, err)
+				assert.Nil(t, listed)
+			})
+		}
+	})
+}
+
+func Test_WaitMaintenanceScheduleRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	schedule := genSchedule("schedule-1")
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID(schedule.ScheduleId)).Return(nil, errors.New("some error")).Once()
+		read, err := maintenance.WaitMaintenanceScheduleRead(context.TODO(), client, mockStack, schedule.ScheduleId)
+		assert.Error(t, err)
+		assert.Nil(t, read)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID(schedule.ScheduleId)).Return(genScheduleResp(http.StatusOK, schedule), nil).Once()
+		read, err := maintenance.WaitMaintenanceScheduleRead(context.TODO(), client, mockStack, schedule.ScheduleId)
+		assert.NoError(t, err)
+		assert.Equal(t, schedule, *read)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID(schedule.ScheduleId)).Return(genScheduleResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("DescribeMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID(schedule.ScheduleId)).Return(genScheduleResp(http.StatusOK, schedule), nil).Once()
+		read, err := maintenance.WaitMaintenanceScheduleRead(context.TODO(), client, mockStack, schedule.ScheduleId)
+		assert.NoError(t, err)
+		assert.Equal(t, schedule, *read)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID(schedule.ScheduleId)).Return(genScheduleResp(statusCode, nil), nil).Once()
+				read, err := maintenance.WaitMaintenanceScheduleRead(context.TODO(), client, mockStack, schedule.ScheduleId)
+				assert.Error(t, err)
+				assert.Nil(t, read)
+			})
+		}
+	})
+}
+
+func Test_WaitMaintenanceScheduleAudit(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	fromTime := v2.FromTime("2024-03-01")
+	toTime := v2.ToTime("2024-03-31T23:59:59Z")
+	params := v2.AuditMaintenanceWindowsScheduleParams{FromTime: &fromTime, ToTime: &toTime}
+	audits := []v2.MaintenanceWindowsSchedule{genSchedule("schedule-1"), genSchedule("schedule-1")}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("AuditMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID("schedule-1"), &params).Return(nil, errors.New("some error")).Once()
+		read, err := maintenance.WaitMaintenanceScheduleAudit(context.TODO(), client, mockStack, "schedule-1", params)
+		assert.Error(t, err)
+		assert.Nil(t, read)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("AuditMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID("schedule-1"), &params).Return(genScheduleResp(http.StatusOK, v2.MaintenanceWindowsAuditResponse{Audits: audits}), nil).Once()
+		read, err := maintenance.WaitMaintenanceScheduleAudit(context.TODO(), client, mockStack, "schedule-1", params)
+		assert.NoError(t, err)
+		assert.Equal(t, audits, read)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("AuditMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID("schedule-1"), &params).Return(genScheduleResp(statusCode, nil), nil).Once()
+				read, err := maintenance.WaitMaintenanceScheduleAudit(context.TODO(), client, mockStack, "schedule-1", params)
+				assert.Error(t, err)
+				assert.Nil(t, read)
+			})
+		}
+	})
+}
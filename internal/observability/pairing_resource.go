@@ -0,0 +1,211 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	PairingResourceKey = "scp_observability_pairing"
+
+	schemaKeyRealm                  = "realm"
+	schemaKeyAccessToken            = "access_token"
+	schemaKeyEnableCentralizedRBAC  = "enable_centralized_rbac"
+	schemaKeyPairingID              = "pairing_id"
+	schemaKeyPairingStatus          = "pairing_status"
+	schemaKeyCentralizedRBACEnabled = "centralized_rbac_enabled"
+)
+
+func pairingResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyRealm: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The Splunk Observability Cloud realm to pair the stack with, e.g. us0. Can not be updated after creation.",
+		},
+		schemaKeyAccessToken: {
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "An admin access token of the Splunk Observability Cloud organization. Only used to authenticate requests to the realm, changing it does not pair the stack again.",
+		},
+		schemaKeyEnableCentralizedRBAC: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Set to true to enable centralized user and role management in Splunk Observability Cloud once the stack is paired. Can not be disabled once enabled. Defaults to false.",
+		},
+		schemaKeyPairingID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the pairing of the stack with the Splunk Observability Cloud organization.",
+		},
+		schemaKeyPairingStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the pairing as returned by ACS.",
+		},
+		schemaKeyCentralizedRBACEnabled: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True once the requests to enable centralized user and role management have succeeded.",
+		},
+	}
+}
+
+func ResourceObservabilityPairing() *schema.Resource {
+	return &schema.Resource{
+		Description: "Observability Pairing Resource. Use this resource to pair a stack with a Splunk Observability Cloud " +
+			"organization and optionally enable centralized user and role management. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Admin/ManageObservability " +
+			"for more latest, detailed information on attribute requirements and the ACS Observability API.",
+
+		CreateContext: resourceObservabilityPairingCreate,
+		ReadContext:   resourceObservabilityPairingRead,
+		UpdateContext: resourceObservabilityPairingUpdate,
+		DeleteContext: resourceObservabilityPairingDelete,
+
+		CustomizeDiff: resourceObservabilityPairingCustomizeDiff,
+
+		Schema: pairingResourceSchema(),
+	}
+}
+
+func resourceObservabilityPairingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	realm := d.Get(schemaKeyRealm).(string)
+	accessToken := d.Get(schemaKeyAccessToken).(string)
+
+	pairingID, err := WaitPairingCreate(ctx, acsClient, stack, realm, accessToken)
+	if err != nil {
+		return diag.Errorf("Error submitting request for pairing with Observability realm (%s): %s", realm, err)
+	}
+
+	// Set ID of pairing resource to the pairing ID assigned by ACS
+	d.SetId(pairingID)
+
+	//Poll until the pairing completes
+	if _, err := WaitVerifyPairingCreate(ctx, acsClient, stack, pairingID, realm, accessToken); err != nil {
+		return diag.Errorf("Error waiting for pairing (%s) with Observability realm (%s) to complete: %s", pairingID, realm, err)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created observability pairing resource: %s\n", pairingID))
+
+	if err := d.Set(schemaKeyCentralizedRBACEnabled, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	if d.Get(schemaKeyEnableCentralizedRBAC).(bool) {
+		// The stack is paired at this point and an error would taint the resource, which pairs the stack again on the next
+		// apply. A failure to enable centralized RBAC is reported as a warning instead and retried by the next apply.
+		diags = enableCentralizedRBAC(ctx, d, m)
+		for i := range diags {
+			diags[i].Severity = diag.Warning
+		}
+	}
+
+	// Call readObservabilityPairing to set attributes of pairing
+	return append(diags, resourceObservabilityPairingRead(ctx, d, m)...)
+}
+
+func resourceObservabilityPairingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	pairingID := d.Id()
+	realm := d.Get(schemaKeyRealm).(string)
+	accessToken := d.Get(schemaKeyAccessToken).(string)
+
+	pairingStatus, err := WaitPairingRead(ctx, acsClient, stack, pairingID, realm, accessToken)
+	if err != nil {
+		// if pairing not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing observability pairing from state. Not Found error while reading pairing (%s): %s.", pairingID, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading pairing (%s) with Observability realm (%s): %s", pairingID, realm, err)
+	}
+
+	if err := d.Set(schemaKeyPairingID, pairingID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyPairingStatus, stringValue(pairingStatus.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceObservabilityPairingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange(schemaKeyEnableCentralizedRBAC) && d.Get(schemaKeyEnableCentralizedRBAC).(bool) {
+		if diags := enableCentralizedRBAC(ctx, d, m); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceObservabilityPairingRead(ctx, d, m)
+}
+
+func resourceObservabilityPairingDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support unpairing a stack, the pairing is only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("Observability pairing (%s) can not be removed through ACS, removing it from state only.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// resourceObservabilityPairingCustomizeDiff fails the plan if centralized RBAC would be disabled, which ACS does not support
+func resourceObservabilityPairingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange(schemaKeyEnableCentralizedRBAC) {
+		return nil
+	}
+	if !d.Get(schemaKeyEnableCentralizedRBAC).(bool) && d.Get(schemaKeyCentralizedRBACEnabled).(bool) {
+		return fmt.Errorf("centralized RBAC can not be disabled once enabled, set %s back to true", schemaKeyEnableCentralizedRBAC)
+	}
+	return nil
+}
+
+// enableCentralizedRBAC enables centralized user and role management for the paired realm and sets centralized_rbac_enabled,
+// if the request fails enable_centralized_rbac is reset to the value it had before the apply
+func enableCentralizedRBAC(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	realm := d.Get(schemaKeyRealm).(string)
+	accessToken := d.Get(schemaKeyAccessToken).(string)
+
+	if err := WaitCentralizedRBACEnable(ctx, acsClient, stack, realm, accessToken); err != nil {
+		// Reset enable_centralized_rbac to its previous value so that the failed request is retried on the next apply
+		oldEnableCentralizedRBAC, _ := d.GetChange(schemaKeyEnableCentralizedRBAC)
+		if err := d.Set(schemaKeyEnableCentralizedRBAC, oldEnableCentralizedRBAC); err != nil {
+			return diag.FromErr(err)
+		}
+		return diag.Errorf("Error enabling centralized RBAC in Observability realm (%s): %s", realm, err)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Enabled centralized RBAC for observability pairing resource: %s\n", d.Id()))
+
+	if err := d.Set(schemaKeyCentralizedRBACEnabled, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
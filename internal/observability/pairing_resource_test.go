@@ -0,0 +1,51 @@
+package observability_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+// preCheckObservability skips the test if no Observability Cloud organization is known to pair the stack with
+func preCheckObservability(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("O11Y_REALM") == "" || os.Getenv("O11Y_ACCESS_TOKEN") == "" {
+		t.Skip("`O11Y_REALM` and `O11Y_ACCESS_TOKEN` must be set for observability acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudObservabilityPairing(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckObservability(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			// Pair stack
+			{
+				Config: testAccInstanceConfigObservabilityPairing(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("scp_observability_pairing.test", "pairing_id"),
+					resource.TestCheckResourceAttr("scp_observability_pairing.test", "centralized_rbac_enabled", "false"),
+				),
+			},
+			// Enable centralized RBAC
+			{
+				Config: testAccInstanceConfigObservabilityPairing(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scp_observability_pairing.test", "centralized_rbac_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceConfigObservabilityPairing(enableCentralizedRBAC bool) string {
+	return fmt.Sprintf(`
+	resource "scp_observability_pairing" "test" {
+		realm                   = %[1]q
+		access_token            = %[2]q
+		enable_centralized_rbac = %[3]t
+	}`, os.Getenv("O11Y_REALM"), os.Getenv("O11Y_ACCESS_TOKEN"), enableCentralizedRBAC)
+}
@@ -0,0 +1,161 @@
+package observability
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// PairingStatusCreate returns StateRefreshFunc that makes POST request to start pairing with the Observability realm and
+// returns the pairing response once the request is accepted
+func PairingStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, realm string, accessToken string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		params := &v2.PostObservabilityPairingParams{O11yAccessToken: accessToken}
+		resp, err := acsClient.PostObservabilityPairing(ctx, string(stack), params, v2.PostObservabilityPairingJSONRequestBody{O11yRealm: &realm})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var pairing v2.CreateEcSsoPairingResponse
+		return processWriteResponse(resp, &pairing, TargetStatusPairingCreated)
+	}
+}
+
+// PairingStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the pairing status response
+func PairingStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, accessToken string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		params := &v2.GetObservabilityPairingStatusParams{O11yRealm: &realm, O11yAccessToken: accessToken}
+		resp, err := acsClient.GetObservabilityPairingStatus(ctx, string(stack), pairingID, params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var pairingStatus v2.GetEcSsoPairingStatusResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &pairingStatus); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &pairingStatus, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// PairingStatusVerifyCreate returns a StateRefreshFunc that makes a GET request and checks if the pairing has completed, a
+// pairing with any status other than pending or complete is returned as error
+func PairingStatusVerifyCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, accessToken string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := PairingStatusRead(ctx, acsClient, stack, pairingID, realm, accessToken)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+		pairingStatus := output.(*v2.GetEcSsoPairingStatusResponse)
+
+		if IsPairingComplete(pairingStatus) {
+			return pairingStatus, status.UpdatedStatus, nil
+		}
+		if IsPairingPending(pairingStatus) {
+			return pairingStatus, http.StatusText(http.StatusOK), nil
+		}
+		return nil, stringValue(pairingStatus.Status), &resource.UnexpectedStateError{
+			State:         stringValue(pairingStatus.Status),
+			ExpectedState: []string{status.UpdatedStatus},
+			LastError:     fmt.Errorf("pairing (%s) with Observability realm (%s) failed", pairingID, realm),
+		}
+	}
+}
+
+// CapabilitiesStatusCreate returns StateRefreshFunc that makes POST request to enable the Observability capabilities on the
+// stack and returns the capabilities response once the request is accepted
+func CapabilitiesStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.PostObservabilityCapabilitiesOnSplunk(ctx, string(stack))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var capabilities v2.EnableObservabilityCapabilitiesResponse
+		return processWriteResponse(resp, &capabilities, wait.TargetStatusResourceChange)
+	}
+}
+
+// CentralizedRBACStatusUpdate returns StateRefreshFunc that makes PUT request to enable centralized RBAC in the Observability
+// realm and checks if response is successful
+func CentralizedRBACStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, realm string, accessToken string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		params := &v2.EnableRbacOnO11yParams{O11yAccessToken: accessToken}
+		resp, err := acsClient.EnableRbacOnO11y(ctx, string(stack), params, v2.EnableRbacOnO11yJSONRequestBody{O11yRealm: &realm})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, TargetStatusCentralizedRBACEnabled, wait.PendingStatusCRUD)
+	}
+}
+
+// IsPairingComplete returns true if ACS reports the pairing as complete
+func IsPairingComplete(pairingStatus *v2.GetEcSsoPairingStatusResponse) bool {
+	return stringValue(pairingStatus.Status) == PairingStatusComplete
+}
+
+// IsPairingPending returns true if ACS reports the pairing as in progress, any status that is neither pending nor complete
+// is a failed pairing
+func IsPairingPending(pairingStatus *v2.GetEcSsoPairingStatusResponse) bool {
+	return slices.Contains(PairingStatusPending, stringValue(pairingStatus.Status))
+}
+
+// processWriteResponse unmarshals the body of an accepted write response into target and returns it, pending responses are
+// returned as is and any other response is an unexpected state
+func processWriteResponse(resp *http.Response, target any, targetStatusCodes []string) (any, string, error) {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	statusText := http.StatusText(resp.StatusCode)
+
+	if slices.Contains(wait.PendingStatusCRUD, statusText) {
+		return resp, statusText, nil
+	}
+	if !slices.Contains(targetStatusCodes, statusText) {
+		return nil, statusText, &resource.UnexpectedStateError{
+			State:         statusText,
+			ExpectedState: targetStatusCodes,
+			LastError:     errors.New(string(bodyBytes)),
+		}
+	}
+
+	if len(bodyBytes) > 0 {
+		if err := json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+	}
+	return target, statusText, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
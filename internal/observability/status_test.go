@@ -0,0 +1,54 @@
+package observability_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/observability"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PairingStatus(t *testing.T) {
+	t.Run("with complete status", func(t *testing.T) {
+		pairingStatus := genPairingStatus("SUCCESS")
+		assert.True(t, observability.IsPairingComplete(pairingStatus))
+		assert.False(t, observability.IsPairingPending(pairingStatus))
+	})
+
+	for _, status := range []string{"IN_PROGRESS", ""} {
+		t.Run("with pending status "+status, func(t *testing.T) {
+			pairingStatus := genPairingStatus(status)
+			assert.False(t, observability.IsPairingComplete(pairingStatus))
+			assert.True(t, observability.IsPairingPending(pairingStatus))
+		})
+	}
+
+	for _, status := range []string{"FAILED", "success"} {
+		t.Run("with failed status "+status, func(t *testing.T) {
+			pairingStatus := genPairingStatus(status)
+			assert.False(t, observability.IsPairingComplete(pairingStatus))
+			assert.False(t, observability.IsPairingPending(pairingStatus))
+		})
+	}
+}
+
+func genPairingStatus(status string) *v2.GetEcSsoPairingStatusResponse {
+	pairingID := mockPairingID
+	return &v2.GetEcSsoPairingStatusResponse{PairingId: &pairingID, Status: &status}
+}
+
+func genJSONResp(statusCode int, body interface{}) *http.Response {
+	b, _ := json.Marshal(body)
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
@@ -0,0 +1,102 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	TargetStatusPairingCreated         = []string{http.StatusText(http.StatusCreated)}
+	TargetStatusCentralizedRBACEnabled = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusAccepted), http.StatusText(http.StatusNoContent)}
+
+	// The pairing status is read until the pairing completes
+	PendingStatusVerifyCreated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+
+	// The pairing status is empty or IN_PROGRESS until the pairing has completed with SUCCESS, any other status is a
+	// failed pairing
+	PairingStatusComplete = "SUCCESS"
+	PairingStatusPending  = []string{"", "IN_PROGRESS"}
+)
+
+// WaitPairingCreate Handles retry logic for POST requests for create lifecycle function and returns the ID of the pairing
+func WaitPairingCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, realm string, accessToken string) (string, error) {
+	waitPairingCreateAccepted := wait.GenerateWriteStateChangeConf(PairingStatusCreate(ctx, acsClient, stack, realm, accessToken))
+	waitPairingCreateAccepted.Target = TargetStatusPairingCreated
+
+	output, err := waitPairingCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for pairing with Observability realm (%s): %s", realm, err))
+		return "", err
+	}
+	pairing := output.(*v2.CreateEcSsoPairingResponse)
+
+	pairingID := stringValue(pairing.PairingId)
+	if pairingID == "" {
+		return "", fmt.Errorf("no pairing ID returned for pairing with Observability realm (%s)", realm)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Started pairing (%s) with Observability realm (%s)\n", pairingID, realm))
+
+	return pairingID, nil
+}
+
+// WaitVerifyPairingCreate waits until the pairing with the Observability realm completes
+func WaitVerifyPairingCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, accessToken string) (*v2.GetEcSsoPairingStatusResponse, error) {
+	waitPairingCreated := wait.GenerateReadStateChangeConf(PendingStatusVerifyCreated, []string{status.UpdatedStatus}, PairingStatusVerifyCreate(ctx, acsClient, stack, pairingID, realm, accessToken))
+
+	output, err := waitPairingCreated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for pairing (%s) with Observability realm (%s) to complete: %s", pairingID, realm, err))
+		return nil, err
+	}
+	pairingStatus := output.(*v2.GetEcSsoPairingStatusResponse)
+
+	return pairingStatus, nil
+}
+
+// WaitPairingRead Handles retry logic for GET requests for the read lifecycle function
+func WaitPairingRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, accessToken string) (*v2.GetEcSsoPairingStatusResponse, error) {
+	waitPairingRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PairingStatusRead(ctx, acsClient, stack, pairingID, realm, accessToken))
+
+	output, err := waitPairingRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading pairing (%s) with Observability realm (%s): %s", pairingID, realm, err))
+		return nil, err
+	}
+	pairingStatus := output.(*v2.GetEcSsoPairingStatusResponse)
+
+	return pairingStatus, nil
+}
+
+// WaitCentralizedRBACEnable enables the Observability capabilities on the stack and then centralized RBAC in the Observability
+// realm, centralized RBAC is enabled once both requests have succeeded
+func WaitCentralizedRBACEnable(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, realm string, accessToken string) error {
+	waitCapabilitiesAccepted := wait.GenerateWriteStateChangeConf(CapabilitiesStatusCreate(ctx, acsClient, stack))
+
+	if _, err := waitCapabilitiesAccepted.WaitForStateContext(ctx); err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for Observability capabilities to be enabled: %s", err))
+		return err
+	}
+
+	waitCentralizedRBACAccepted := wait.GenerateWriteStateChangeConf(CentralizedRBACStatusUpdate(ctx, acsClient, stack, realm, accessToken))
+	waitCentralizedRBACAccepted.Target = TargetStatusCentralizedRBACEnabled
+
+	rawResp, err := waitCentralizedRBACAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for centralized RBAC to be enabled in Observability realm (%s): %s", realm, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and centralized RBAC enabled
+	tflog.Info(ctx, fmt.Sprintf("Enable centralized RBAC response status code for Observability realm (%s): %d\n", realm, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for Observability realm (%s): %s\n", realm, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
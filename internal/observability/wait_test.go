@@ -0,0 +1,185 @@
+package observability_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack       = "mock-stack"
+	mockRealm       = "us0"
+	mockAccessToken = "mock-access-token"
+	mockPairingID   = "mock-pairing-id"
+)
+
+var (
+	unexpectedStatusCodes = []int{400, 401, 403, 404, 501, 503}
+
+	mockRealmValue = mockRealm
+)
+
+func Test_WaitPairingCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	params := &v2.PostObservabilityPairingParams{O11yAccessToken: mockAccessToken}
+	body := v2.PostObservabilityPairingJSONRequestBody{O11yRealm: &mockRealmValue}
+	pairingID := mockPairingID
+	mockPairing := v2.CreateEcSsoPairingResponse{PairingId: &pairingID}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, params, body).Return(nil, errors.New("some error")).Once()
+		_, err := observability.WaitPairingCreate(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 201", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, params, body).Return(genJSONResp(http.StatusCreated, mockPairing), nil).Once()
+		id, err := observability.WaitPairingCreate(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPairingID, id)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, params, body).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, params, body).Return(genJSONResp(http.StatusCreated, mockPairing), nil).Once()
+		id, err := observability.WaitPairingCreate(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPairingID, id)
+	})
+
+	t.Run("with no pairing ID", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, params, body).Return(genRawResp(http.StatusCreated, "{}"), nil).Once()
+		_, err := observability.WaitPairingCreate(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("PostObservabilityPairing", mock.Anything, mockStack, params, body).Return(genRawResp(statusCode, ""), nil).Once()
+				_, err := observability.WaitPairingCreate(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitPairingRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	params := &v2.GetObservabilityPairingStatusParams{O11yRealm: &mockRealmValue, O11yAccessToken: mockAccessToken}
+	mockPairingStatus := genPairingStatus("SUCCESS")
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genJSONResp(http.StatusOK, mockPairingStatus), nil).Once()
+		pairingStatus, err := observability.WaitPairingRead(context.TODO(), client, mockStack, mockPairingID, mockRealm, mockAccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPairingStatus, pairingStatus)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genJSONResp(http.StatusOK, mockPairingStatus), nil).Once()
+		pairingStatus, err := observability.WaitPairingRead(context.TODO(), client, mockStack, mockPairingID, mockRealm, mockAccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPairingStatus, pairingStatus)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genRawResp(statusCode, ""), nil).Once()
+				pairingStatus, err := observability.WaitPairingRead(context.TODO(), client, mockStack, mockPairingID, mockRealm, mockAccessToken)
+				assert.Error(t, err)
+				assert.Nil(t, pairingStatus)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyPairingCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	params := &v2.GetObservabilityPairingStatusParams{O11yRealm: &mockRealmValue, O11yAccessToken: mockAccessToken}
+
+	t.Run("with pairing in progress then complete", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genJSONResp(http.StatusOK, genPairingStatus("IN_PROGRESS")), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genJSONResp(http.StatusOK, genPairingStatus("SUCCESS")), nil).Once()
+		pairingStatus, err := observability.WaitVerifyPairingCreate(context.TODO(), client, mockStack, mockPairingID, mockRealm, mockAccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCESS", *pairingStatus.Status)
+	})
+
+	t.Run("with pairing failed", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genJSONResp(http.StatusOK, genPairingStatus("FAILED")), nil).Once()
+		_, err := observability.WaitVerifyPairingCreate(context.TODO(), client, mockStack, mockPairingID, mockRealm, mockAccessToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("with unknown pairing status", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genJSONResp(http.StatusOK, genPairingStatus("CANCELLED")), nil).Once()
+		_, err := observability.WaitVerifyPairingCreate(context.TODO(), client, mockStack, mockPairingID, mockRealm, mockAccessToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, params).Return(genRawResp(statusCode, ""), nil).Once()
+				_, err := observability.WaitVerifyPairingCreate(context.TODO(), client, mockStack, mockPairingID, mockRealm, mockAccessToken)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitCentralizedRBACEnable(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	params := &v2.EnableRbacOnO11yParams{O11yAccessToken: mockAccessToken}
+	body := v2.EnableRbacOnO11yJSONRequestBody{O11yRealm: &mockRealmValue}
+	capabilitiesRBACEnabled := false
+
+	t.Run("with centralized RBAC enabled after capabilities response", func(t *testing.T) {
+		client.On("PostObservabilityCapabilitiesOnSplunk", mock.Anything, mockStack).Return(genJSONResp(http.StatusAccepted, v2.EnableObservabilityCapabilitiesResponse{CentralizedRBACEnabled: &capabilitiesRBACEnabled}), nil).Once()
+		client.On("EnableRbacOnO11y", mock.Anything, mockStack, params, body).Return(genRawResp(http.StatusOK, ""), nil).Once()
+		err := observability.WaitCentralizedRBACEnable(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable responses 429", func(t *testing.T) {
+		client.On("PostObservabilityCapabilitiesOnSplunk", mock.Anything, mockStack).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("PostObservabilityCapabilitiesOnSplunk", mock.Anything, mockStack).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		client.On("EnableRbacOnO11y", mock.Anything, mockStack, params, body).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("EnableRbacOnO11y", mock.Anything, mockStack, params, body).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := observability.WaitCentralizedRBACEnable(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses to capabilities request", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("PostObservabilityCapabilitiesOnSplunk", mock.Anything, mockStack).Return(genRawResp(statusCode, ""), nil).Once()
+				err := observability.WaitCentralizedRBACEnable(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("with unexpected http responses to centralized RBAC request", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("PostObservabilityCapabilitiesOnSplunk", mock.Anything, mockStack).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+				client.On("EnableRbacOnO11y", mock.Anything, mockStack, params, body).Return(genRawResp(statusCode, ""), nil).Once()
+				err := observability.WaitCentralizedRBACEnable(context.TODO(), client, mockStack, mockRealm, mockAccessToken)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
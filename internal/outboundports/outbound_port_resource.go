@@ -0,0 +1,270 @@
+package outboundports
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	ResourceKey = "scp_outbound_ports"
+
+	schemaKeyPort    = "port"
+	schemaKeySubnets = "subnets"
+	schemaKeyReason  = "reason"
+)
+
+// OutboundPortResourceConfig holds what differs between the IPv4 and IPv6 outbound port resources, which otherwise
+// share their schema and lifecycle functions
+type OutboundPortResourceConfig struct {
+	// Name is the name of the resource used in descriptions and messages, e.g. "outbound port"
+	Name              string
+	Description       string
+	SubnetDescription string
+	ValidateSubnet    schema.SchemaValidateDiagFunc
+
+	List         func(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.OutboundResponse, error)
+	Read         func(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) ([]string, error)
+	Create       func(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, reason string) error
+	Delete       func(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string) error
+	VerifyUpdate func(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, present bool) error
+}
+
+func outboundPortResourceSchema(config OutboundPortResourceConfig) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyPort: {
+			Type:             schema.TypeInt,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+			Description: fmt.Sprintf("The %s to open for the stack. No two resources should have the same port. ", config.Name) +
+				"Can not be updated after creation, if changed in config file terraform will propose a replacement.",
+		},
+		schemaKeySubnets: {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: config.ValidateSubnet,
+			},
+			Set:         utils.HashSubnet,
+			Description: config.SubnetDescription,
+			MinItems:    1,
+		},
+		schemaKeyReason: {
+			Type:     schema.TypeString,
+			Optional: true,
+			// The reason is never returned by ACS, so changes after creation are ignored rather than shown as a diff
+			// that can not be applied
+			DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+				return d.Id() != ""
+			},
+			Description: fmt.Sprintf("The reason for opening the %s. Only sent when the port is created, ", config.Name) +
+				"changes after creation are ignored.",
+		},
+	}
+}
+
+// NewOutboundPortResource returns an outbound port resource that makes its requests with the functions of the given config
+func NewOutboundPortResource(config OutboundPortResourceConfig) *schema.Resource {
+	return &schema.Resource{
+		Description: config.Description,
+
+		CreateContext: outboundPortCreate(config),
+		ReadContext:   outboundPortRead(config),
+		UpdateContext: outboundPortUpdate(config),
+		DeleteContext: outboundPortDelete(config),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: utils.CustomizeDiffSubnets(schemaKeySubnets),
+
+		Schema: outboundPortResourceSchema(config),
+	}
+}
+
+func ResourceOutboundPort() *schema.Resource {
+	return NewOutboundPortResource(OutboundPortResourceConfig{
+		Name: "outbound port",
+		Description: "Outbound Ports Resource. Please see documentation to understand unique behavior regarding naming and delete operation. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureOutboundPorts " +
+			"for more latest, detailed information on attribute requirements and the ACS Outbound Ports API.",
+		SubnetDescription: "Subnets is a list of destination IPv4 subnets the stack is allowed to reach on the port.",
+		ValidateSubnet:    utils.ValidateIPv4Subnet,
+
+		List:         WaitOutboundPortList,
+		Read:         WaitOutboundPortRead,
+		Create:       WaitOutboundPortCreate,
+		Delete:       WaitOutboundPortDelete,
+		VerifyUpdate: WaitVerifyOutboundPortUpdate,
+	})
+}
+
+func outboundPortCreate(config OutboundPortResourceConfig) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// use the meta value to retrieve client and stack from the provider configure method
+		acsProvider := m.(client.ACSProvider)
+		acsClient := *acsProvider.Client
+		stack := acsProvider.Stack
+
+		// Retrieve data for each field and create request body
+		port, _, newSubnetsSet, reason := parseOutboundPortRequest(d)
+		addSubnets := utils.GetSubnetsFromSet(newSubnetsSet)
+
+		// Adding subnets to an existing port would silently merge them, so require the port to be imported instead
+		outboundPorts, err := config.List(ctx, acsClient, stack)
+		if err != nil {
+			return diag.Errorf("Error listing %ss: %s", config.Name, err)
+		}
+		for _, outboundPort := range outboundPorts {
+			if outboundPort.Port != nil && *outboundPort.Port == port {
+				return diag.Errorf("%s (%d) already exists, use terraform import to bring the current port under terraform management", config.Name, port)
+			}
+		}
+
+		if err = config.Create(ctx, acsClient, stack, port, addSubnets, reason); err != nil {
+			return diag.Errorf("Error submitting request for %s (%d) to be created: %s", config.Name, port, err)
+		}
+
+		//Poll until the subnets have been added to the port
+		if err = config.VerifyUpdate(ctx, acsClient, stack, port, addSubnets, true); err != nil {
+			return diag.Errorf("Error waiting for %s (%d) to be created: %s", config.Name, port, err)
+		}
+
+		// Set ID of outbound port resource to indicate port has been created
+		d.SetId(strconv.Itoa(int(port)))
+		tflog.Info(ctx, fmt.Sprintf("Created %s resource: %d\n", config.Name, port))
+
+		// Call read to set attributes of outbound port
+		return outboundPortRead(config)(ctx, d, m)
+	}
+}
+
+func outboundPortRead(config OutboundPortResourceConfig) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// use the meta value to retrieve your client from the provider configure method
+		acsProvider := m.(client.ACSProvider)
+		acsClient := *acsProvider.Client
+		stack := acsProvider.Stack
+
+		port, err := parseOutboundPortID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		subnets, err := config.Read(ctx, acsClient, stack, port)
+		if err != nil {
+			// if port not found set id of resource to empty string to remove from state
+			if errors.IsNotFoundError(err) {
+				tflog.Info(ctx, fmt.Sprintf("Removing %s from state. Not Found error while reading %s (%d): %s.", config.Name, config.Name, port, err))
+				d.SetId("")
+				return nil //if we return an error here, the set id will not take effect and state will be preserved
+			}
+			return diag.Errorf("Error reading %s (%d): %s", config.Name, port, err)
+		}
+
+		if err := d.Set(schemaKeyPort, int(port)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set(schemaKeySubnets, subnets); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+func outboundPortUpdate(config OutboundPortResourceConfig) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// use the meta value to retrieve client and stack from the provider configure method
+		acsProvider := m.(client.ACSProvider)
+		acsClient := *acsProvider.Client
+		stack := acsProvider.Stack
+
+		// Determine the changes to the subnets of a port
+		port, oldSubnetsSet, newSubnetsSet, reason := parseOutboundPortRequest(d)
+		addSubnets := utils.GetSubnetsFromSet(newSubnetsSet.Difference(oldSubnetsSet))
+		deleteSubnets := utils.GetSubnetsFromSet(oldSubnetsSet.Difference(newSubnetsSet))
+
+		if len(deleteSubnets) > 0 {
+			if err := config.Delete(ctx, acsClient, stack, port, deleteSubnets); err != nil {
+				return diag.Errorf("Error updating %s (%d): %s", config.Name, port, err)
+			}
+			if err := config.VerifyUpdate(ctx, acsClient, stack, port, deleteSubnets, false); err != nil {
+				return diag.Errorf("Error waiting for %s (%d) to be updated: %s", config.Name, port, err)
+			}
+		}
+
+		if len(addSubnets) > 0 {
+			if err := config.Create(ctx, acsClient, stack, port, addSubnets, reason); err != nil {
+				return diag.Errorf("Error updating %s (%d): %s", config.Name, port, err)
+			}
+			if err := config.VerifyUpdate(ctx, acsClient, stack, port, addSubnets, true); err != nil {
+				return diag.Errorf("Error waiting for %s (%d) to be updated: %s", config.Name, port, err)
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Updated %s resource: %d\n", config.Name, port))
+
+		return outboundPortRead(config)(ctx, d, m)
+	}
+}
+
+func outboundPortDelete(config OutboundPortResourceConfig) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// use the meta value to retrieve client and stack from the provider configure method
+		acsProvider := m.(client.ACSProvider)
+		acsClient := *acsProvider.Client
+		stack := acsProvider.Stack
+
+		port, oldSubnetsSet, _, _ := parseOutboundPortRequest(d)
+		deleteSubnets := utils.GetSubnetsFromSet(oldSubnetsSet)
+
+		if len(deleteSubnets) > 0 {
+			if err := config.Delete(ctx, acsClient, stack, port, deleteSubnets); err != nil {
+				// if port not found the subnets have already been removed
+				if errors.IsNotFoundError(err) {
+					tflog.Info(ctx, fmt.Sprintf("%s (%d) not found: %s.", config.Name, port, err))
+					return nil
+				}
+				return diag.Errorf("Error deleting %s (%d): %s", config.Name, port, err)
+			}
+			if err := config.VerifyUpdate(ctx, acsClient, stack, port, deleteSubnets, false); err != nil {
+				return diag.Errorf("Error waiting for %s (%d) to be deleted: %s", config.Name, port, err)
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Deleted %s resource: %d\n", config.Name, port))
+		return nil
+	}
+}
+
+func parseOutboundPortRequest(d *schema.ResourceData) (port int32, oldSubnets *schema.Set, newSubnets *schema.Set, reason string) {
+	port = int32(d.Get(schemaKeyPort).(int))
+	reason = d.Get(schemaKeyReason).(string)
+
+	rawOriginalSubnets, rawNewSubnets := d.GetChange(schemaKeySubnets)
+	oldSubnets = rawOriginalSubnets.(*schema.Set)
+	newSubnets = rawNewSubnets.(*schema.Set)
+	return port, oldSubnets, newSubnets, reason
+}
+
+// parseOutboundPortID returns the port of the outbound port resource with the given ID
+func parseOutboundPortID(id string) (int32, error) {
+	port, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid outbound port ID (%s), expected the port number: %w", id, err)
+	}
+	return int32(port), nil
+}
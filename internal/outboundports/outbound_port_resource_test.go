@@ -0,0 +1,62 @@
+package outboundports_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+var (
+	outboundPort          = 8089
+	outboundSubnets       = []string{"10.0.0.0/24"}
+	outboundSubnetsUpdate = []string{"10.0.0.0/24", "10.0.1.0/24"}
+)
+
+func resourcePrefix(resourceName string) string {
+	return fmt.Sprint("scp_outbound_ports.", resourceName)
+}
+
+func TestAcc_SplunkCloudOutboundPort(t *testing.T) {
+	resourceName := resource.UniqueId()
+
+	outboundPortResourceTest := []resource.TestStep{
+		// Open outbound port
+		{
+			Config: testAccInstanceConfigOutboundPort(resourceName, outboundSubnets),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "port", fmt.Sprint(outboundPort)),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "subnets.#", fmt.Sprint(len(outboundSubnets))),
+			),
+		},
+		// Add a subnet to the outbound port
+		{
+			Config: testAccInstanceConfigOutboundPort(resourceName, outboundSubnetsUpdate),
+			Check:  resource.TestCheckResourceAttr(resourcePrefix(resourceName), "subnets.#", fmt.Sprint(len(outboundSubnetsUpdate))),
+		},
+		// Import outbound port
+		{
+			ResourceName:            resourcePrefix(resourceName),
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"reason"},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps:             outboundPortResourceTest,
+	})
+}
+
+func testAccInstanceConfigOutboundPort(resourceName string, subnets []string) string {
+	subnetList, _ := json.Marshal(subnets)
+	return fmt.Sprintf(`resource "scp_outbound_ports" %[1]q {
+		port    = %[2]d
+		subnets = %[3]s
+		reason  = "terraform acceptance test"
+	}`, resourceName, outboundPort, subnetList)
+}
@@ -0,0 +1,183 @@
+package outboundports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// OutboundPortStatusCreate returns StateRefreshFunc that makes POST request to add subnets to an outbound port and checks if request was accepted
+func OutboundPortStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, reason string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		createBody := v2.AddOutboundportsJSONRequestBody{
+			OutboundPorts: &[]struct {
+				Port    *int32    `json:"port,omitempty"`
+				Subnets *[]string `json:"subnets,omitempty"`
+			}{{Port: &port, Subnets: &subnets}},
+		}
+		if reason != "" {
+			createBody.Reason = &reason
+		}
+		resp, err := acsClient.AddOutboundports(ctx, stack, createBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// OutboundPortStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the subnets of the outbound port
+func OutboundPortStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundports(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		subnets := make([]string, 0)
+		if resp.StatusCode == http.StatusOK {
+			if subnets, err = parseOutboundPortSubnets(bodyBytes, port); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return subnets, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// OutboundPortStatusList returns StateRefreshFunc that makes GET request, checks if request was successful, and returns all outbound ports
+func OutboundPortStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetOutboundports(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		outboundPorts := make([]v2.OutboundResponse, 0)
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &outboundPorts); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return outboundPorts, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// OutboundPortStatusDelete returns StateRefreshFunc that makes DELETE request to remove subnets from an outbound port and checks if request was accepted
+func OutboundPortStatusDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deleteBody := v2.DeleteOutboundportJSONRequestBody{
+			Subnets: &subnets,
+		}
+		resp, err := acsClient.DeleteOutboundport(ctx, stack, port, deleteBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// OutboundPortStatusVerify returns StateRefreshFunc that makes GET request and checks if the given subnets have been
+// added to (present) or removed from (not present) the outbound port
+func OutboundPortStatusVerify(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, present bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundports(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; ok {
+			return nil, http.StatusText(resp.StatusCode), nil
+		}
+
+		currentSubnets := make([]string, 0)
+		switch resp.StatusCode {
+		case http.StatusOK:
+			if currentSubnets, err = parseOutboundPortSubnets(bodyBytes, port); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		case http.StatusNotFound:
+			// the port is removed once its last subnet has been deleted
+		default:
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: []string{status.UpdatedStatus},
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		if VerifySubnets(subnets, currentSubnets, present) {
+			return currentSubnets, status.UpdatedStatus, nil
+		}
+		return currentSubnets, http.StatusText(http.StatusOK), nil
+	}
+}
+
+// VerifySubnets is a helper to verify that all subnets are either present or absent in the current subnets,
+// subnets are compared in their canonical form
+func VerifySubnets(subnets []string, currentSubnets []string, present bool) bool {
+	current := map[string]bool{}
+	for _, subnet := range currentSubnets {
+		current[utils.NormalizeSubnet(subnet)] = true
+	}
+	for _, subnet := range subnets {
+		if current[utils.NormalizeSubnet(subnet)] != present {
+			return false
+		}
+	}
+	return true
+}
+
+// parseOutboundPortSubnets returns the destination subnets of the given port from a describe outbound port response
+func parseOutboundPortSubnets(bodyBytes []byte, port int32) ([]string, error) {
+	var outboundPorts []v2.OutboundResponse
+	if err := json.Unmarshal(bodyBytes, &outboundPorts); err != nil {
+		return nil, err
+	}
+
+	subnets := make([]string, 0)
+	for _, outboundPort := range outboundPorts {
+		if outboundPort.Port != nil && *outboundPort.Port != port {
+			continue
+		}
+		if outboundPort.DestinationRanges != nil {
+			subnets = append(subnets, *outboundPort.DestinationRanges...)
+		}
+	}
+	return subnets, nil
+}
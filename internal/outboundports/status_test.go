@@ -0,0 +1,82 @@
+package outboundports_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_OutboundPortStatusVerify(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with subnets not yet added", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK, mockSubnets[:1]), nil).Once()
+		_, state, err := outboundports.OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets, true)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), state)
+	})
+
+	t.Run("with subnets added", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		_, state, err := outboundports.OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets, true)()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, state)
+	})
+
+	t.Run("with subnets not yet removed", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		_, state, err := outboundports.OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets[:1], false)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), state)
+	})
+
+	t.Run("with port not found after removing subnets", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusNotFound, nil), nil).Once()
+		_, state, err := outboundports.OutboundPortStatusVerify(context.TODO(), client, mockStack, mockPort, mockSubnets, false)()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, state)
+	})
+}
+
+func Test_VerifySubnets(t *testing.T) {
+	assert.True(t, outboundports.VerifySubnets(mockSubnets, mockSubnets, true))
+	assert.False(t, outboundports.VerifySubnets(mockSubnets, mockSubnets[:1], true))
+	assert.True(t, outboundports.VerifySubnets(mockSubnets[1:], mockSubnets[:1], false))
+	assert.False(t, outboundports.VerifySubnets(mockSubnets, mockSubnets[:1], false))
+	assert.True(t, outboundports.VerifySubnets(mockSubnets, nil, false))
+}
+
+func genOutboundPortResp(statusCode int, subnets []string) *http.Response {
+	if statusCode != http.StatusOK {
+		return genOutboundPortErrResp(statusCode, http.StatusText(statusCode))
+	}
+
+	port := mockPort
+	destinationRanges := subnets
+	b, _ := json.Marshal([]v2.OutboundResponse{{Port: &port, DestinationRanges: &destinationRanges}})
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader(b)),
+	}
+}
+
+func genOutboundPortErrResp(statusCode int, message string) *http.Response {
+	b, _ := json.Marshal(&v2.Error{
+		Code:    message,
+		Message: message,
+	})
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader(b)),
+	}
+}
@@ -0,0 +1,102 @@
+package outboundports
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// Outbound port changes are either processed synchronously (200) or accepted as a deployment task (202)
+	TargetStatusResourceChange = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusAccepted)}
+
+	// Outbound ports are read back until the deployment task has been applied
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitOutboundPortCreate Handles retry logic for POST requests adding subnets to an outbound port for the create and update lifecycle functions
+func WaitOutboundPortCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, reason string) error {
+	waitOutboundPortCreateAccepted := wait.GenerateWriteStateChangeConf(OutboundPortStatusCreate(ctx, acsClient, stack, port, subnets, reason))
+	waitOutboundPortCreateAccepted.Target = TargetStatusResourceChange
+
+	rawResp, err := waitOutboundPortCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for outbound port (%d) to be created: %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and creation in progress
+	tflog.Info(ctx, fmt.Sprintf("Create response status code for outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitOutboundPortRead Handles retry logic for GET requests for the read lifecycle function
+func WaitOutboundPortRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) ([]string, error) {
+	waitOutboundPortRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, OutboundPortStatusRead(ctx, acsClient, stack, port))
+
+	output, err := waitOutboundPortRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading outbound port (%d): %s", port, err))
+		return nil, err
+	}
+	subnets := output.([]string)
+
+	return subnets, nil
+}
+
+// WaitOutboundPortList Handles retry logic for GET requests listing all outbound ports
+func WaitOutboundPortList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.OutboundResponse, error) {
+	waitOutboundPortList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, OutboundPortStatusList(ctx, acsClient, stack))
+
+	output, err := waitOutboundPortList.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error listing outbound ports: %s", err))
+		return nil, err
+	}
+	outboundPorts := output.([]v2.OutboundResponse)
+
+	return outboundPorts, nil
+}
+
+// WaitOutboundPortDelete Handles retry logic for DELETE requests removing subnets from an outbound port for the update and delete lifecycle functions
+func WaitOutboundPortDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string) error {
+	waitOutboundPortDeleteAccepted := wait.GenerateWriteStateChangeConf(OutboundPortStatusDelete(ctx, acsClient, stack, port, subnets))
+	waitOutboundPortDeleteAccepted.Target = TargetStatusResourceChange
+
+	rawResp, err := waitOutboundPortDeleteAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for outbound port (%d) to be deleted: %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and deletion in progress
+	tflog.Info(ctx, fmt.Sprintf("Delete response status code for outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyOutboundPortUpdate Handles retry logic for polling after POST and DELETE requests until the subnets have been
+// added to (present) or removed from (not present) the outbound port
+func WaitVerifyOutboundPortUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, subnets []string, present bool) error {
+	waitOutboundPortUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, OutboundPortStatusVerify(ctx, acsClient, stack, port, subnets, present))
+
+	_, err := waitOutboundPortUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming outbound port (%d) has been updated: %s", port, err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package outboundports_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack  = "mock-stack"
+	mockPort   = int32(8089)
+	mockReason = "mock-reason"
+)
+
+var (
+	unexpectedStatusCodes     = []int{400, 401, 403, 404, 409, 501, 503}
+	unexpectedStatusCodesPoll = []int{400, 401, 403, 409, 501, 500, 503}
+
+	mockSubnets = []string{"1.1.1.1/32", "1.1.1.2/32"}
+)
+
+func mockCreateBody(subnets []string) v2.AddOutboundportsJSONRequestBody {
+	port := mockPort
+	reason := mockReason
+	return v2.AddOutboundportsJSONRequestBody{
+		OutboundPorts: &[]struct {
+			Port    *int32    `json:"port,omitempty"`
+			Subnets *[]string `json:"subnets,omitempty"`
+		}{{Port: &port, Subnets: &subnets}},
+		Reason: &reason,
+	}
+}
+
+func Test_WaitOutboundPortCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	createBody := mockCreateBody(mockSubnets)
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), createBody).Return(nil, errors.New("some error")).Once()
+		err := outboundports.WaitOutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), createBody).Return(genOutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := outboundports.WaitOutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), createBody).Return(genOutboundPortResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), createBody).Return(genOutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := outboundports.WaitOutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), createBody).Return(genOutboundPortResp(statusCode, nil), nil).Once()
+				err := outboundports.WaitOutboundPortCreate(context.TODO(), client, mockStack, mockPort, mockSubnets, mockReason)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(nil, errors.New("some error")).Once()
+		subnets, err := outboundports.WaitOutboundPortRead(context.TODO(), client, mockStack, mockPort)
+		assert.Error(t, err)
+		assert.Nil(t, subnets)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		subnets, err := outboundports.WaitOutboundPortRead(context.TODO(), client, mockStack, mockPort)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, mockSubnets, subnets)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		subnets, err := outboundports.WaitOutboundPortRead(context.TODO(), client, mockStack, mockPort)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, mockSubnets, subnets)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(statusCode, nil), nil).Once()
+				subnets, err := outboundports.WaitOutboundPortRead(context.TODO(), client, mockStack, mockPort)
+				assert.Error(t, err)
+				assert.Nil(t, subnets)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("GetOutboundports", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		outboundPorts, err := outboundports.WaitOutboundPortList(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, outboundPorts)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetOutboundports", mock.Anything, v2.Stack(mockStack)).Return(genOutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		outboundPorts, err := outboundports.WaitOutboundPortList(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Len(t, outboundPorts, 1)
+		assert.Equal(t, mockPort, *outboundPorts[0].Port)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetOutboundports", mock.Anything, v2.Stack(mockStack)).Return(genOutboundPortResp(statusCode, nil), nil).Once()
+				outboundPorts, err := outboundports.WaitOutboundPortList(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, outboundPorts)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortDelete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	deleteBody := v2.DeleteOutboundportJSONRequestBody{Subnets: &mockSubnets}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(nil, errors.New("some error")).Once()
+		err := outboundports.WaitOutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genOutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := outboundports.WaitOutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with dependency incomplete response 424", func(t *testing.T) {
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genOutboundPortErrResp(http.StatusFailedDependency, "424-dependency-incomplete"), nil).Once()
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genOutboundPortResp(http.StatusAccepted, nil), nil).Once()
+		err := outboundports.WaitOutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with failed dependency response 424", func(t *testing.T) {
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genOutboundPortErrResp(http.StatusFailedDependency, "424-failed-dependency"), nil).Once()
+		err := outboundports.WaitOutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+		assert.Error(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, deleteBody).Return(genOutboundPortResp(statusCode, nil), nil).Once()
+				err := outboundports.WaitOutboundPortDelete(context.TODO(), client, mockStack, mockPort, mockSubnets)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyOutboundPortUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with subnets added after pending update", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK, mockSubnets[:1]), nil).Once()
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK, mockSubnets), nil).Once()
+		err := outboundports.WaitVerifyOutboundPortUpdate(context.TODO(), client, mockStack, mockPort, mockSubnets, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with subnets removed and port not found", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusNotFound, nil), nil).Once()
+		err := outboundports.WaitVerifyOutboundPortUpdate(context.TODO(), client, mockStack, mockPort, mockSubnets, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodesPoll {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(statusCode, nil), nil).Once()
+				err := outboundports.WaitVerifyOutboundPortUpdate(context.TODO(), client, mockStack, mockPort, mockSubnets, true)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,331 @@
+package privateconnectivity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_private_connectivity"
+
+	schemaKeyCustomerAccountIDs = "customer_account_ids"
+	schemaKeyFeatures           = "features"
+	schemaKeyEndpoints          = "endpoints"
+	schemaKeyFeature            = "feature"
+	schemaKeyEndpoint           = "endpoint"
+	schemaKeyResourceID         = "resource_id"
+	schemaKeyDNSRecords         = "dns_records"
+	schemaKeyStatus             = "status"
+	schemaKeyTargetSubResource  = "target_sub_resource"
+	schemaKeyMessage            = "message"
+)
+
+func privateConnectivityResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyCustomerAccountIDs: {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			},
+			Description: "The AWS account IDs or Azure subscription IDs that are allowed to connect to the private endpoints of the stack.",
+		},
+		schemaKeyFeatures: {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(Features, false)),
+			},
+			Description: "The features to enable private connectivity for. Valid values are `search` and `ingest`. " +
+				"A feature can not be removed once enabled, as ACS does not support disabling private connectivity of a feature.",
+		},
+		schemaKeyEndpoints: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: endpointSchema(),
+			},
+			Description: "The private endpoints of the stack, one per enabled feature.",
+		},
+	}
+}
+
+// endpointSchema returns the computed attributes of a private connectivity endpoint
+func endpointSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyFeature: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The feature of the endpoint, either `search` or `ingest`.",
+		},
+		schemaKeyEndpoint: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The endpoint service of the stack to connect to from the customer accounts.",
+		},
+		schemaKeyResourceID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource ID of the endpoint service.",
+		},
+		schemaKeyDNSRecords: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The DNS records to create in the customer network to resolve the stack to the private endpoint.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the endpoint.",
+		},
+		schemaKeyTargetSubResource: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The target sub-resource of the endpoint, used by Azure private endpoints.",
+		},
+		schemaKeyMessage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Any message returned by ACS about the endpoint.",
+		},
+	}
+}
+
+func ResourcePrivateConnectivity() *schema.Resource {
+	return &schema.Resource{
+		Description: "Private Connectivity Resource. Use this resource to enable private connectivity for search and ingest " +
+			"to a stack from the given AWS accounts or Azure subscriptions. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Admin/PrivateConnectivityEnable " +
+			"for more latest, detailed information on attribute requirements and the ACS Private Connectivity API.",
+
+		CreateContext: resourcePrivateConnectivityCreate,
+		ReadContext:   resourcePrivateConnectivityRead,
+		UpdateContext: resourcePrivateConnectivityUpdate,
+		DeleteContext: resourcePrivateConnectivityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourcePrivateConnectivityCustomizeDiff,
+
+		Schema: privateConnectivityResourceSchema(),
+	}
+}
+
+func resourcePrivateConnectivityCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// The eligibility is checked again as it may have changed since the plan
+	eligibility, err := WaitEligibilityRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error checking eligibility of stack (%s) for private connectivity: %s", stack, err)
+	}
+	if err := CheckEligibility(eligibility); err != nil {
+		return diag.FromErr(err)
+	}
+
+	features, customerAccountIDs := parseFeatures(d), parseCustomerAccountIDs(d)
+	enableRequest := v2.EnablePrivateConnectivityJSONRequestBody{
+		CustomerAccountIds: &customerAccountIDs,
+		Feature:            (*v2.PrivateConnectivityFeatures)(&features),
+	}
+
+	if err := WaitPrivateConnectivityEnable(ctx, acsClient, stack, enableRequest); err != nil {
+		return diag.Errorf("Error submitting request for private connectivity to be enabled: %s", err)
+	}
+
+	// Poll until an endpoint of every feature allows all the customer accounts
+	if err := WaitVerifyPrivateConnectivityUpdate(ctx, acsClient, stack, features, customerAccountIDs); err != nil {
+		return diag.Errorf("Error waiting for private connectivity to be enabled: %s", err)
+	}
+
+	// Private connectivity is configured once per stack, the stack is used as ID of the resource
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Enabled private connectivity resource: %s\n", stack))
+
+	return resourcePrivateConnectivityRead(ctx, d, m)
+}
+
+func resourcePrivateConnectivityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// The endpoints of all features are read, as ACS can not disable a feature every enabled feature is managed
+	privateConnectivity, err := WaitPrivateConnectivityRead(ctx, acsClient, stack, "")
+	if err != nil {
+		// if private connectivity not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing private connectivity from state. Not Found error while reading private connectivity (%s): %s.", d.Id(), err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading private connectivity (%s): %s", d.Id(), err)
+	}
+	endpoints := EndpointsValue(privateConnectivity)
+
+	// if no feature is enabled set id of resource to empty string to remove from state
+	if len(endpoints) == 0 {
+		tflog.Info(ctx, fmt.Sprintf("Removing private connectivity from state. No endpoints found for stack (%s).", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(schemaKeyFeatures, endpointFeatures(endpoints)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyCustomerAccountIDs, endpointCustomerAccountIDs(endpoints)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyEndpoints, flattenEndpoints(endpoints)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePrivateConnectivityUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChanges(schemaKeyCustomerAccountIDs, schemaKeyFeatures) {
+		features, customerAccountIDs := parseFeatures(d), parseCustomerAccountIDs(d)
+		updateRequest := v2.UpdatePrivateConnectivityJSONRequestBody{
+			CustomerAccountIds: &customerAccountIDs,
+			Feature:            (*v2.PrivateConnectivityFeatures)(&features),
+		}
+
+		if err := WaitPrivateConnectivityUpdate(ctx, acsClient, stack, updateRequest); err != nil {
+			return diag.Errorf("Error submitting request for private connectivity (%s) to be updated: %s", d.Id(), err)
+		}
+
+		// Poll until an endpoint of every feature allows all the customer accounts
+		if err := WaitVerifyPrivateConnectivityUpdate(ctx, acsClient, stack, features, customerAccountIDs); err != nil {
+			return diag.Errorf("Error waiting for private connectivity (%s) to be updated: %s", d.Id(), err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated private connectivity resource: %s\n", d.Id()))
+	}
+
+	return resourcePrivateConnectivityRead(ctx, d, m)
+}
+
+func resourcePrivateConnectivityDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support disabling private connectivity, the configuration is only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("Private connectivity of stack (%s) can not be disabled through ACS, removing it from state only.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// resourcePrivateConnectivityCustomizeDiff fails the plan if private connectivity would be enabled on a stack that is not eligible,
+// or if a feature would be removed as ACS can not disable private connectivity of a feature
+func resourcePrivateConnectivityCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		if !d.HasChange(schemaKeyFeatures) {
+			return nil
+		}
+		oldFeatures, newFeatures := d.GetChange(schemaKeyFeatures)
+		if removedFeatures := expandStringSet(oldFeatures.(*schema.Set).Difference(newFeatures.(*schema.Set))); len(removedFeatures) > 0 {
+			return fmt.Errorf("private connectivity can not be disabled for features (%s), ACS does not support disabling private connectivity of a feature",
+				strings.Join(removedFeatures, ", "))
+		}
+		return nil
+	}
+
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	eligibility, err := WaitEligibilityRead(ctx, acsClient, stack)
+	if err != nil {
+		return fmt.Errorf("error checking eligibility of stack (%s) for private connectivity: %s", stack, err)
+	}
+	return CheckEligibility(eligibility)
+}
+
+func parseFeatures(d *schema.ResourceData) []string {
+	return expandStringSet(d.Get(schemaKeyFeatures).(*schema.Set))
+}
+
+func parseCustomerAccountIDs(d *schema.ResourceData) []string {
+	return expandStringSet(d.Get(schemaKeyCustomerAccountIDs).(*schema.Set))
+}
+
+// expandStringSet converts a set of strings to a sorted slice
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	sort.Strings(values)
+	return values
+}
+
+// endpointFeatures returns the features of the endpoints
+func endpointFeatures(endpoints []v2.PrivateConnectivityEndpoints) []string {
+	features := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if feature := stringValue(endpoint.Feature); feature != "" {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// endpointCustomerAccountIDs returns the customer account IDs allowed by any of the endpoints. Updates apply the same
+// account IDs to every feature, so an account ID that is only allowed by some endpoints shows as a diff until it is removed.
+func endpointCustomerAccountIDs(endpoints []v2.PrivateConnectivityEndpoints) []string {
+	customerAccountIDs := make([]string, 0)
+	for _, endpoint := range endpoints {
+		for _, customerAccountID := range stringSliceValue(endpoint.CustomerAccountIds) {
+			if !slices.Contains(customerAccountIDs, customerAccountID) {
+				customerAccountIDs = append(customerAccountIDs, customerAccountID)
+			}
+		}
+	}
+	sort.Strings(customerAccountIDs)
+	return customerAccountIDs
+}
+
+// flattenEndpoints converts private connectivity endpoints to a list of attributes of the endpoint schema
+func flattenEndpoints(endpoints []v2.PrivateConnectivityEndpoints) []map[string]interface{} {
+	flattenedEndpoints := make([]map[string]interface{}, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		flattenedEndpoints = append(flattenedEndpoints, map[string]interface{}{
+			schemaKeyFeature:           stringValue(endpoint.Feature),
+			schemaKeyEndpoint:          stringValue(endpoint.Endpoint),
+			schemaKeyResourceID:        stringValue(endpoint.ResourceId),
+			schemaKeyDNSRecords:        stringSliceValue(endpoint.PrivateSearchDNSRecords),
+			schemaKeyStatus:            stringValue(endpoint.Status),
+			schemaKeyTargetSubResource: stringValue(endpoint.TargetSubResource),
+			schemaKeyMessage:           stringValue(endpoint.Message),
+		})
+	}
+	return flattenedEndpoints
+}
@@ -0,0 +1,53 @@
+package privateconnectivity_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+// preCheckPrivateConnectivity skips the test if no customer account is known to allow private connectivity from
+func preCheckPrivateConnectivity(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("PRIVATE_CONNECTIVITY_ACCOUNT_ID") == "" {
+		t.Skip("`PRIVATE_CONNECTIVITY_ACCOUNT_ID` must be set for private connectivity acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudPrivateConnectivity(t *testing.T) {
+	accountID := os.Getenv("PRIVATE_CONNECTIVITY_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckPrivateConnectivity(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			// Enable private connectivity for search
+			{
+				Config: testAccInstanceConfigPrivateConnectivity(accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scp_private_connectivity.test", "features.#", "1"),
+					resource.TestCheckTypeSetElemAttr("scp_private_connectivity.test", "customer_account_ids.*", accountID),
+					resource.TestCheckResourceAttr("scp_private_connectivity.test", "endpoints.0.feature", "search"),
+					resource.TestCheckResourceAttrSet("scp_private_connectivity.test", "endpoints.0.endpoint"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "scp_private_connectivity.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceConfigPrivateConnectivity(accountID string) string {
+	return fmt.Sprintf(`
+	resource "scp_private_connectivity" "test" {
+		customer_account_ids = [%q]
+		features             = ["search"]
+	}`, accountID)
+}
@@ -0,0 +1,178 @@
+package privateconnectivity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// EligibilityStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the eligibility of the stack
+func EligibilityStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ValidatePrivateConnectivity(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var eligibility v2.DescribeEligibilityPrivateConnectivity
+		return status.ProcessReadResponse(resp, &eligibility)
+	}
+}
+
+// PrivateConnectivityStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the
+// private connectivity endpoints of the feature, or of all features if feature is empty
+func PrivateConnectivityStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, feature string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		params := &v2.DescribePrivateConnectivityParams{}
+		if feature != "" {
+			params.Feature = &feature
+		}
+		resp, err := acsClient.DescribePrivateConnectivity(ctx, stack, params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var privateConnectivity v2.DescribePrivateConnectivity
+		return status.ProcessReadResponse(resp, &privateConnectivity)
+	}
+}
+
+// PrivateConnectivityStatusEnable returns StateRefreshFunc that makes POST request and checks if response is accepted
+func PrivateConnectivityStatusEnable(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, enableRequest v2.EnablePrivateConnectivityJSONRequestBody) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.EnablePrivateConnectivity(ctx, stack, enableRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// PrivateConnectivityStatusUpdate returns StateRefreshFunc that makes PATCH request and checks if response is accepted
+func PrivateConnectivityStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, updateRequest v2.UpdatePrivateConnectivityJSONRequestBody) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.UpdatePrivateConnectivity(ctx, stack, updateRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// PrivateConnectivityStatusVerify returns a StateRefreshFunc that makes a GET request and checks if there is an endpoint for
+// every feature that allows all the customer account IDs, an endpoint that failed is returned as error with its reason
+func PrivateConnectivityStatusVerify(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, features []string, customerAccountIDs []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := PrivateConnectivityStatusRead(ctx, acsClient, stack, "")()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+		privateConnectivity := output.(*v2.DescribePrivateConnectivity)
+		endpoints := EndpointsValue(privateConnectivity)
+
+		for _, endpoint := range endpoints {
+			if IsEndpointFailed(endpoint) {
+				return nil, stringValue(endpoint.Status), &resource.UnexpectedStateError{
+					State:         stringValue(endpoint.Status),
+					ExpectedState: []string{status.UpdatedStatus},
+					LastError:     fmt.Errorf("private connectivity of feature (%s) failed: %s", stringValue(endpoint.Feature), stringValue(endpoint.Reason)),
+				}
+			}
+		}
+		if !IsPrivateConnectivityApplied(endpoints, features, customerAccountIDs) {
+			return privateConnectivity, http.StatusText(http.StatusOK), nil
+		}
+		return privateConnectivity, status.UpdatedStatus, nil
+	}
+}
+
+// CheckEligibility returns an error with the reason given by ACS if the stack is not eligible for private connectivity
+func CheckEligibility(eligibility *v2.DescribeEligibilityPrivateConnectivity) error {
+	if eligibility.Eligible != nil && *eligibility.Eligible {
+		return nil
+	}
+	reason := stringValue(eligibility.Reason)
+	if reason == "" {
+		reason = "no reason given"
+	}
+	return fmt.Errorf("stack is not eligible for private connectivity: %s", reason)
+}
+
+// IsPrivateConnectivityApplied returns true if every feature has an endpoint that allows exactly the customer account IDs,
+// so that both added and removed account IDs have been applied
+func IsPrivateConnectivityApplied(endpoints []v2.PrivateConnectivityEndpoints, features []string, customerAccountIDs []string) bool {
+	for _, feature := range features {
+		endpoint := FindEndpoint(endpoints, feature)
+		if endpoint == nil || stringValue(endpoint.Endpoint) == "" {
+			return false
+		}
+		if !isSameStringSet(stringSliceValue(endpoint.CustomerAccountIds), customerAccountIDs) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEndpointFailed returns true if ACS reports the endpoint as failed
+func IsEndpointFailed(endpoint v2.PrivateConnectivityEndpoints) bool {
+	return slices.Contains(EndpointStatusFailed, strings.ToLower(stringValue(endpoint.Status)))
+}
+
+// FindEndpoint returns the endpoint of the feature, or nil if there is none
+func FindEndpoint(endpoints []v2.PrivateConnectivityEndpoints, feature string) *v2.PrivateConnectivityEndpoints {
+	for i := range endpoints {
+		if stringValue(endpoints[i].Feature) == feature {
+			return &endpoints[i]
+		}
+	}
+	return nil
+}
+
+// EndpointsValue returns the endpoints of the private connectivity response, or an empty list if there are none
+func EndpointsValue(privateConnectivity *v2.DescribePrivateConnectivity) []v2.PrivateConnectivityEndpoints {
+	if privateConnectivity.Endpoints == nil {
+		return []v2.PrivateConnectivityEndpoints{}
+	}
+	return *privateConnectivity.Endpoints
+}
+
+// isSameStringSet returns true if both slices hold the same strings, ignoring order and duplicates
+func isSameStringSet(values []string, otherValues []string) bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	otherSet := make(map[string]bool, len(otherValues))
+	for _, value := range otherValues {
+		if !set[value] {
+			return false
+		}
+		otherSet[value] = true
+	}
+	return len(set) == len(otherSet)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func stringSliceValue(values *[]string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return *values
+}
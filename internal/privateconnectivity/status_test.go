@@ -0,0 +1,92 @@
+package privateconnectivity_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/privateconnectivity"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckEligibility(t *testing.T) {
+	eligible, notEligible := true, false
+	reason := "stack is not hosted on AWS"
+
+	t.Run("with eligible stack", func(t *testing.T) {
+		err := privateconnectivity.CheckEligibility(&v2.DescribeEligibilityPrivateConnectivity{Eligible: &eligible})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with stack not eligible", func(t *testing.T) {
+		err := privateconnectivity.CheckEligibility(&v2.DescribeEligibilityPrivateConnectivity{Eligible: &notEligible, Reason: &reason})
+		assert.ErrorContains(t, err, reason)
+	})
+
+	t.Run("with unknown eligibility", func(t *testing.T) {
+		err := privateconnectivity.CheckEligibility(&v2.DescribeEligibilityPrivateConnectivity{})
+		assert.Error(t, err)
+	})
+}
+
+func Test_IsPrivateConnectivityApplied(t *testing.T) {
+	endpoints := []v2.PrivateConnectivityEndpoints{
+		genEndpoint(privateconnectivity.FeatureSearch, "available", mockAccountID, mockOtherAccountID),
+		genEndpoint(privateconnectivity.FeatureIngest, "available", mockAccountID),
+	}
+
+	t.Run("with all features and accounts applied", func(t *testing.T) {
+		assert.True(t, privateconnectivity.IsPrivateConnectivityApplied(endpoints, []string{privateconnectivity.FeatureSearch}, []string{mockAccountID, mockOtherAccountID}))
+		assert.True(t, privateconnectivity.IsPrivateConnectivityApplied(endpoints, []string{privateconnectivity.FeatureIngest}, []string{mockAccountID}))
+	})
+
+	t.Run("with removed account still allowed", func(t *testing.T) {
+		assert.False(t, privateconnectivity.IsPrivateConnectivityApplied(endpoints, privateconnectivity.Features, []string{mockAccountID}))
+	})
+
+	t.Run("with account not yet allowed", func(t *testing.T) {
+		assert.False(t, privateconnectivity.IsPrivateConnectivityApplied(endpoints, privateconnectivity.Features, []string{mockAccountID, mockOtherAccountID}))
+	})
+
+	t.Run("with feature not yet enabled", func(t *testing.T) {
+		assert.False(t, privateconnectivity.IsPrivateConnectivityApplied(endpoints[:1], privateconnectivity.Features, []string{mockAccountID}))
+	})
+
+	t.Run("with endpoint not yet provisioned", func(t *testing.T) {
+		pending := []v2.PrivateConnectivityEndpoints{{Feature: &privateconnectivity.Features[0], CustomerAccountIds: &[]string{mockAccountID}}}
+		assert.False(t, privateconnectivity.IsPrivateConnectivityApplied(pending, []string{privateconnectivity.FeatureSearch}, []string{mockAccountID}))
+	})
+}
+
+func Test_IsEndpointFailed(t *testing.T) {
+	assert.True(t, privateconnectivity.IsEndpointFailed(genEndpoint(privateconnectivity.FeatureSearch, "Failed", mockAccountID)))
+	assert.False(t, privateconnectivity.IsEndpointFailed(genEndpoint(privateconnectivity.FeatureSearch, "available", mockAccountID)))
+	assert.False(t, privateconnectivity.IsEndpointFailed(v2.PrivateConnectivityEndpoints{}))
+}
+
+func genEndpoint(feature string, status string, customerAccountIDs ...string) v2.PrivateConnectivityEndpoints {
+	endpoint := "com.amazonaws.vpce.us-east-1.vpce-svc-" + feature
+	resourceID := "vpce-svc-" + feature
+	return v2.PrivateConnectivityEndpoints{
+		Feature:            &feature,
+		Status:             &status,
+		Endpoint:           &endpoint,
+		ResourceId:         &resourceID,
+		CustomerAccountIds: &customerAccountIDs,
+	}
+}
+
+func genJSONResp(statusCode int, body interface{}) *http.Response {
+	b, _ := json.Marshal(body)
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
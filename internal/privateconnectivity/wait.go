@@ -0,0 +1,107 @@
+package privateconnectivity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	FeatureSearch = "search"
+	FeatureIngest = "ingest"
+)
+
+var (
+	Features = []string{FeatureSearch, FeatureIngest}
+
+	// Endpoints are read until every feature has an endpoint that allows all customer account IDs
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+
+	// Lower case statuses of an endpoint that failed to be provisioned
+	EndpointStatusFailed = []string{"failed", "error"}
+)
+
+// WaitEligibilityRead Handles retry logic for GET requests checking the eligibility of the stack for private connectivity
+func WaitEligibilityRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.DescribeEligibilityPrivateConnectivity, error) {
+	waitEligibilityRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, EligibilityStatusRead(ctx, acsClient, stack))
+
+	output, err := waitEligibilityRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error checking eligibility for private connectivity: %s", err))
+		return nil, err
+	}
+	eligibility := output.(*v2.DescribeEligibilityPrivateConnectivity)
+
+	return eligibility, nil
+}
+
+// WaitPrivateConnectivityRead Handles retry logic for GET requests reading the private connectivity endpoints of the feature,
+// or of all features if feature is empty
+func WaitPrivateConnectivityRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, feature string) (*v2.DescribePrivateConnectivity, error) {
+	waitPrivateConnectivityRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PrivateConnectivityStatusRead(ctx, acsClient, stack, feature))
+
+	output, err := waitPrivateConnectivityRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading private connectivity: %s", err))
+		return nil, err
+	}
+	privateConnectivity := output.(*v2.DescribePrivateConnectivity)
+
+	return privateConnectivity, nil
+}
+
+// WaitPrivateConnectivityEnable Handles retry logic for POST requests for create lifecycle function
+func WaitPrivateConnectivityEnable(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, enableRequest v2.EnablePrivateConnectivityJSONRequestBody) error {
+	waitPrivateConnectivityEnableAccepted := wait.GenerateWriteStateChangeConf(PrivateConnectivityStatusEnable(ctx, acsClient, stack, enableRequest))
+
+	rawResp, err := waitPrivateConnectivityEnableAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for private connectivity to be enabled: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and creation in progress
+	tflog.Info(ctx, fmt.Sprintf("Enable response status code for private connectivity: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for private connectivity: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitPrivateConnectivityUpdate Handles retry logic for PATCH requests for update lifecycle function
+func WaitPrivateConnectivityUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, updateRequest v2.UpdatePrivateConnectivityJSONRequestBody) error {
+	waitPrivateConnectivityUpdateAccepted := wait.GenerateWriteStateChangeConf(PrivateConnectivityStatusUpdate(ctx, acsClient, stack, updateRequest))
+
+	rawResp, err := waitPrivateConnectivityUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for private connectivity to be updated: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for private connectivity: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for private connectivity: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyPrivateConnectivityUpdate waits until every feature has an endpoint that allows all the customer account IDs
+func WaitVerifyPrivateConnectivityUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, features []string, customerAccountIDs []string) error {
+	waitPrivateConnectivityUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, PrivateConnectivityStatusVerify(ctx, acsClient, stack, features, customerAccountIDs))
+
+	_, err := waitPrivateConnectivityUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for private connectivity to be updated: %s", err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package privateconnectivity_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/privateconnectivity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack          = "mock-stack"
+	mockAccountID      = "123456789012"
+	mockOtherAccountID = "210987654321"
+)
+
+var (
+	unexpectedStatusCodes = []int{400, 401, 403, 404, 501, 503}
+
+	mockFeatures = v2.PrivateConnectivityFeatures{privateconnectivity.FeatureSearch}
+	mockRequest  = v2.EnablePrivateConnectivityJSONRequestBody{CustomerAccountIds: &[]string{mockAccountID}, Feature: &mockFeatures}
+	mockUpdate   = v2.UpdatePrivateConnectivityJSONRequestBody{CustomerAccountIds: &[]string{mockAccountID}, Feature: &mockFeatures}
+)
+
+func Test_WaitEligibilityRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	eligible := true
+	mockEligibility := v2.DescribeEligibilityPrivateConnectivity{Eligible: &eligible}
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("ValidatePrivateConnectivity", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, mockEligibility), nil).Once()
+		eligibility, err := privateconnectivity.WaitEligibilityRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, mockEligibility, *eligibility)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("ValidatePrivateConnectivity", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("ValidatePrivateConnectivity", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, mockEligibility), nil).Once()
+		eligibility, err := privateconnectivity.WaitEligibilityRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, mockEligibility, *eligibility)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ValidatePrivateConnectivity", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				eligibility, err := privateconnectivity.WaitEligibilityRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, eligibility)
+			})
+		}
+	})
+}
+
+func Test_WaitPrivateConnectivityRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	feature := privateconnectivity.FeatureSearch
+	params := &v2.DescribePrivateConnectivityParams{Feature: &feature}
+	mockPrivateConnectivity := v2.DescribePrivateConnectivity{Endpoints: &[]v2.PrivateConnectivityEndpoints{genEndpoint(feature, "available", mockAccountID)}}
+
+	t.Run("with feature filter", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, mockPrivateConnectivity), nil).Once()
+		privateConnectivity, err := privateconnectivity.WaitPrivateConnectivityRead(context.TODO(), client, mockStack, feature)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPrivateConnectivity, *privateConnectivity)
+	})
+
+	t.Run("without feature filter", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), &v2.DescribePrivateConnectivityParams{}).Return(genJSONResp(http.StatusOK, mockPrivateConnectivity), nil).Once()
+		privateConnectivity, err := privateconnectivity.WaitPrivateConnectivityRead(context.TODO(), client, mockStack, "")
+		assert.NoError(t, err)
+		assert.Equal(t, mockPrivateConnectivity, *privateConnectivity)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, mockPrivateConnectivity), nil).Once()
+		privateConnectivity, err := privateconnectivity.WaitPrivateConnectivityRead(context.TODO(), client, mockStack, feature)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPrivateConnectivity, *privateConnectivity)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genRawResp(statusCode, ""), nil).Once()
+				privateConnectivity, err := privateconnectivity.WaitPrivateConnectivityRead(context.TODO(), client, mockStack, feature)
+				assert.Error(t, err)
+				assert.Nil(t, privateConnectivity)
+			})
+		}
+	})
+}
+
+func Test_WaitPrivateConnectivityEnable(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockRequest).Return(nil, errors.New("some error")).Once()
+		err := privateconnectivity.WaitPrivateConnectivityEnable(context.TODO(), client, mockStack, mockRequest)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockRequest).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := privateconnectivity.WaitPrivateConnectivityEnable(context.TODO(), client, mockStack, mockRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockRequest).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockRequest).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := privateconnectivity.WaitPrivateConnectivityEnable(context.TODO(), client, mockStack, mockRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockRequest).Return(genRawResp(statusCode, ""), nil).Once()
+				err := privateconnectivity.WaitPrivateConnectivityEnable(context.TODO(), client, mockStack, mockRequest)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitPrivateConnectivityUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("UpdatePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockUpdate).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := privateconnectivity.WaitPrivateConnectivityUpdate(context.TODO(), client, mockStack, mockUpdate)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("UpdatePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockUpdate).Return(genRawResp(statusCode, ""), nil).Once()
+				err := privateconnectivity.WaitPrivateConnectivityUpdate(context.TODO(), client, mockStack, mockUpdate)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyPrivateConnectivityUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	params := &v2.DescribePrivateConnectivityParams{}
+	features := []string{privateconnectivity.FeatureSearch}
+	pending := v2.DescribePrivateConnectivity{Endpoints: &[]v2.PrivateConnectivityEndpoints{genEndpoint(privateconnectivity.FeatureSearch, "pending")}}
+	applied := v2.DescribePrivateConnectivity{Endpoints: &[]v2.PrivateConnectivityEndpoints{genEndpoint(privateconnectivity.FeatureSearch, "available", mockAccountID)}}
+	failed := v2.DescribePrivateConnectivity{Endpoints: &[]v2.PrivateConnectivityEndpoints{genEndpoint(privateconnectivity.FeatureSearch, "failed")}}
+
+	t.Run("with endpoint applied", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, applied), nil).Once()
+		err := privateconnectivity.WaitVerifyPrivateConnectivityUpdate(context.TODO(), client, mockStack, features, []string{mockAccountID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with endpoint pending then applied", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, pending), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, applied), nil).Once()
+		err := privateconnectivity.WaitVerifyPrivateConnectivityUpdate(context.TODO(), client, mockStack, features, []string{mockAccountID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with endpoint failed", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genJSONResp(http.StatusOK, failed), nil).Once()
+		err := privateconnectivity.WaitVerifyPrivateConnectivityUpdate(context.TODO(), client, mockStack, features, []string{mockAccountID})
+		assert.Error(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), params).Return(genRawResp(statusCode, ""), nil).Once()
+				err := privateconnectivity.WaitVerifyPrivateConnectivityUpdate(context.TODO(), client, mockStack, features, []string{mockAccountID})
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -9,11 +9,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/apppermissions"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/splunk/terraform-provider-scp/internal/emek"
+	"github.com/splunk/terraform-provider-scp/internal/federated"
 	"github.com/splunk/terraform-provider-scp/internal/hec"
 	"github.com/splunk/terraform-provider-scp/internal/indexes"
 	"github.com/splunk/terraform-provider-scp/internal/ipallowlists"
 	"github.com/splunk/terraform-provider-scp/internal/ipv6allowlists"
+	"github.com/splunk/terraform-provider-scp/internal/ipv6outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/limits"
+	"github.com/splunk/terraform-provider-scp/internal/maintenance"
+	"github.com/splunk/terraform-provider-scp/internal/observability"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/privateconnectivity"
 	"github.com/splunk/terraform-provider-scp/internal/roles"
+	"github.com/splunk/terraform-provider-scp/internal/selfstorage"
+	"github.com/splunk/terraform-provider-scp/internal/stack"
 	"github.com/splunk/terraform-provider-scp/internal/users"
 )
 
@@ -52,19 +64,46 @@ func New(version string) func() *schema.Provider {
 // Returns a map of splunk resources for configuration
 func providerResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		indexes.ResourceKey:        indexes.ResourceIndex(),
-		hec.ResourceKey:            hec.ResourceHecToken(),
-		ipallowlists.ResourceKey:   ipallowlists.ResourceIPAllowlist(),
-		ipv6allowlists.ResourceKey: ipv6allowlists.ResourceIPv6Allowlist(),
-		roles.ResourceKey:          roles.ResourceRole(),
-		users.ResourceKey:          users.ResourceUser(),
+		indexes.ResourceKey:                 indexes.ResourceIndex(),
+		hec.ResourceKey:                     hec.ResourceHecToken(),
+		ipallowlists.ResourceKey:            ipallowlists.ResourceIPAllowlist(),
+		ipv6allowlists.ResourceKey:          ipv6allowlists.ResourceIPv6Allowlist(),
+		ipallowlists.SubnetResourceKey:      ipallowlists.ResourceIPAllowlistSubnet(),
+		ipv6allowlists.SubnetResourceKey:    ipv6allowlists.ResourceIPv6AllowlistSubnet(),
+		limits.ResourceKey:                  limits.ResourceLimitsConfig(),
+		maintenance.ChangeFreezeResourceKey: maintenance.ResourceChangeFreeze(),
+		roles.ResourceKey:                   roles.ResourceRole(),
+		users.ResourceKey:                   users.ResourceUser(),
+		apps.ResourceKey:                    apps.ResourceApp(),
+		apppermissions.ResourceKey:          apppermissions.ResourceAppPermissions(),
+		outboundports.ResourceKey:           outboundports.ResourceOutboundPort(),
+		ipv6outboundports.ResourceKey:       ipv6outboundports.ResourceIPv6OutboundPort(),
+		selfstorage.ResourceKey:             selfstorage.ResourceSelfStorageLocation(),
+		emek.ResourceKey:                    emek.ResourceEmekKey(),
+		federated.GlueResourcesResourceKey:  federated.ResourceGlueResources(),
+		privateconnectivity.ResourceKey:     privateconnectivity.ResourcePrivateConnectivity(),
+		observability.PairingResourceKey:    observability.ResourceObservabilityPairing(),
+		stack.PythonVersionResourceKey:      stack.ResourcePythonVersion(),
+		stack.RestartResourceKey:            stack.ResourceStackRestart(),
 	}
 }
 
 // Returns a map of Splunk data sources for configuration
 func providerDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		indexes.ResourceKey: indexes.DataSourceIndex(),
+		indexes.ResourceKey:                      indexes.DataSourceIndex(),
+		apps.DataSourceExportKey:                 apps.DataSourceAppExport(),
+		ipallowlists.DataSourceKey:               ipallowlists.DataSourceIPAllowlist(),
+		ipv6allowlists.DataSourceKey:             ipv6allowlists.DataSourceIPv6Allowlist(),
+		limits.DataSourceKey:                     limits.DataSourceLimits(),
+		maintenance.SchedulesDataSourceKey:       maintenance.DataSourceMaintenanceSchedules(),
+		maintenance.ScheduleDataSourceKey:        maintenance.DataSourceMaintenanceSchedule(),
+		maintenance.ScheduleAuditDataSourceKey:   maintenance.DataSourceMaintenanceScheduleAudit(),
+		selfstorage.PolicyDataSourceKey:          selfstorage.DataSourceSelfStorageLocationPolicy(),
+		selfstorage.PrefixDataSourceKey:          selfstorage.DataSourceSelfStorageLocationPrefix(),
+		selfstorage.ServiceAccountsDataSourceKey: selfstorage.DataSourceSelfStorageLocationServiceAccounts(),
+		emek.PolicyDataSourceKey:                 emek.DataSourceEmekPolicy(),
+		stack.DataSourceKey:                      stack.DataSourceStack(),
 	}
 }
 
@@ -155,6 +194,16 @@ func configure(ctx context.Context, d *schema.ResourceData, version string) (int
 		return nil, diag.FromErr(err)
 	}
 
+	// determine the experience of the stack once so resources can route requests to the right endpoints,
+	// a failure leaves the stack type empty and is reported by the resources that depend on it
+	stackType, err := client.GetStackType(ctx, acsClient, stackName.(string))
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to determine the experience of stack %s: %v", stackName.(string), err))
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Stack %s is a %s experience stack", stackName.(string), stackType))
+	}
+
 	provider.Client = &acsClient
+	provider.StackType = stackType
 	return provider, nil
 }
@@ -0,0 +1,37 @@
+package selfstorage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const selfStorageLocationDataSourceTemplate = `
+data "scp_self_storage_location_prefix" %[1]q {}
+
+data "scp_self_storage_location_service_accounts" %[1]q {}
+
+data "scp_self_storage_location_policy" %[1]q {
+	bucket_name = "${data.scp_self_storage_location_prefix.%[1]s.prefix}terraform-acceptance"
+}
+`
+
+func TestAcc_SplunkCloudSelfStorageLocation_DataSource_basic(t *testing.T) {
+	dataSourceName := "ddss"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(selfStorageLocationDataSourceTemplate, dataSourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_self_storage_location_prefix.%s", dataSourceName), "prefix"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_self_storage_location_policy.%s", dataSourceName), "policy"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_self_storage_location_service_accounts.%s", dataSourceName), "id"),
+				),
+			},
+		},
+	})
+}
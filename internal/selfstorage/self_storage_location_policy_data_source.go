@@ -0,0 +1,88 @@
+package selfstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	PolicyDataSourceKey = "scp_self_storage_location_policy"
+
+	schemaKeyPolicy  = "policy"
+	schemaKeyMessage = "message"
+)
+
+func selfStorageLocationPolicyDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyBucketName: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The name of the S3 or GCS bucket to generate the policy for.",
+		},
+		schemaKeyPolicy: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The bucket policy as a JSON string, to be applied to the bucket before the self storage location is created.",
+		},
+		schemaKeyMessage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Instructions from ACS on applying the bucket policy.",
+		},
+	}
+}
+
+func DataSourceSelfStorageLocationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Policy Data Source. Use this data source to generate the bucket policy that grants " +
+			"the stack access to an S3 or GCS bucket before a self storage location is created in it. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations " +
+			"for more latest, detailed information on the ACS Self Storage Locations API.",
+
+		ReadContext: dataSourceSelfStorageLocationPolicyRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: selfStorageLocationPolicyDataSourceSchema(),
+	}
+}
+
+func dataSourceSelfStorageLocationPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	bucketName := d.Get(schemaKeyBucketName).(string)
+
+	policy, err := WaitSelfStorageLocationPolicyRead(ctx, acsClient, stack, bucketName)
+	if err != nil {
+		return diag.Errorf("Error reading self storage location policy for bucket (%s): %s", bucketName, err)
+	}
+
+	policyJSON, err := utils.FlattenPolicy(policy.Policy)
+	if err != nil {
+		return diag.Errorf("Error encoding self storage location policy for bucket (%s): %s", bucketName, err)
+	}
+
+	if err := d.Set(schemaKeyPolicy, policyJSON); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyMessage, policy.Message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", stack, bucketName))
+
+	return nil
+}
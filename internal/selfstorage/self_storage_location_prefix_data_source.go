@@ -0,0 +1,71 @@
+package selfstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	PrefixDataSourceKey = "scp_self_storage_location_prefix"
+
+	schemaKeyPrefix = "prefix"
+)
+
+func selfStorageLocationPrefixDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyPrefix: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The prefix the name of a self storage location bucket must start with.",
+		},
+		schemaKeyMessage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Instructions from ACS on naming the bucket.",
+		},
+	}
+}
+
+func DataSourceSelfStorageLocationPrefix() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Prefix Data Source. Use this data source to look up the prefix the name of an " +
+			"S3 or GCS bucket must start with to be used as a self storage location. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations " +
+			"for more latest, detailed information on the ACS Self Storage Locations API.",
+
+		ReadContext: dataSourceSelfStorageLocationPrefixRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: selfStorageLocationPrefixDataSourceSchema(),
+	}
+}
+
+func dataSourceSelfStorageLocationPrefixRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	prefix, err := WaitSelfStorageLocationPrefixRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading self storage location prefix: %s", err)
+	}
+
+	if err := d.Set(schemaKeyPrefix, prefix.Prefix); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyMessage, prefix.Message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
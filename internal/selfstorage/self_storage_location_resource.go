@@ -0,0 +1,187 @@
+package selfstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_self_storage_location"
+
+	schemaKeyBucketName  = "bucket_name"
+	schemaKeyFolder      = "folder"
+	schemaKeyTitle       = "title"
+	schemaKeyDescription = "description"
+	schemaKeyURI         = "uri"
+	schemaKeyBucketPath  = "bucket_path"
+)
+
+func selfStorageLocationResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyBucketName: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The name of the existing S3 or GCS bucket. The bucket name must start with the prefix required by ACS. Can not be updated after creation.",
+		},
+		schemaKeyFolder: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The folder in the bucket to store the data in. Can not be updated after creation.",
+		},
+		schemaKeyTitle: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The title of the self storage location. Can not be updated after creation.",
+		},
+		schemaKeyDescription: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The description of the self storage location. Can not be updated after creation.",
+		},
+		schemaKeyURI: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URI of the self storage location.",
+		},
+		schemaKeyBucketPath: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The bucket path of the self storage location, to be used as the self_storage_bucket_path of an index.",
+		},
+	}
+}
+
+func ResourceSelfStorageLocation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Resource. Use this resource to create a Dynamic Data Self Storage (DDSS) location " +
+			"in an existing S3 or GCS bucket, which indexes can then reference by its bucket path. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations " +
+			"for more latest, detailed information on attribute requirements and the ACS Self Storage Locations API.",
+
+		CreateContext: resourceSelfStorageLocationCreate,
+		ReadContext:   resourceSelfStorageLocationRead,
+		DeleteContext: resourceSelfStorageLocationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: selfStorageLocationResourceSchema(),
+	}
+}
+
+func resourceSelfStorageLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	createRequest := parseSelfStorageLocationRequest(d)
+	folder := d.Get(schemaKeyFolder).(string)
+
+	err := WaitSelfStorageLocationCreate(ctx, acsClient, stack, createRequest)
+	if err != nil {
+		if errors.IsConflictError(err) {
+			return diag.Errorf("Self storage location (%s) already exists, use terraform import to bring the current self storage location under terraform management", createRequest.BucketName)
+		}
+		return diag.Errorf("Error submitting request for self storage location (%s) to be created: %s", createRequest.BucketName, err)
+	}
+
+	//Poll until the self storage location is listed and has been assigned a bucket path
+	location, err := WaitVerifySelfStorageLocationCreate(ctx, acsClient, stack, createRequest.BucketName, folder)
+	if err != nil {
+		return diag.Errorf("Error waiting for self storage location (%s) to be created: %s", createRequest.BucketName, err)
+	}
+
+	// Set ID of self storage location resource to the bucket path assigned by ACS
+	d.SetId(location.BucketPath)
+	tflog.Info(ctx, fmt.Sprintf("Created self storage location resource: %s\n", location.BucketPath))
+
+	// Call readSelfStorageLocation to set attributes of self storage location
+	return resourceSelfStorageLocationRead(ctx, d, m)
+}
+
+func resourceSelfStorageLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	bucketPath := d.Id()
+
+	location, err := WaitSelfStorageLocationRead(ctx, acsClient, stack, bucketPath)
+	if err != nil {
+		// if self storage location not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing self storage location from state. Not Found error while reading self storage location (%s): %s.", bucketPath, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading self storage location (%s): %s", bucketPath, err)
+	}
+
+	if err := d.Set(schemaKeyBucketName, location.BucketName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyFolder, location.Folder); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyTitle, location.Title); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyDescription, location.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyURI, location.Uri); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyBucketPath, location.BucketPath); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSelfStorageLocationDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support deleting self storage locations, the location is only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("Self storage location (%s) can not be deleted through ACS, removing it from state only.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+func parseSelfStorageLocationRequest(d *schema.ResourceData) v2.CreateSelfStorageLocationJSONRequestBody {
+	request := v2.CreateSelfStorageLocationJSONRequestBody{
+		BucketName: d.Get(schemaKeyBucketName).(string),
+		Title:      d.Get(schemaKeyTitle).(string),
+	}
+
+	if folder, ok := d.GetOk(schemaKeyFolder); ok {
+		parsedFolder := folder.(string)
+		request.Folder = &parsedFolder
+	}
+
+	if description, ok := d.GetOk(schemaKeyDescription); ok {
+		parsedDescription := description.(string)
+		request.Description = &parsedDescription
+	}
+
+	return request
+}
@@ -0,0 +1,61 @@
+package selfstorage_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+func resourcePrefix(resourceName string) string {
+	return fmt.Sprint("scp_self_storage_location.", resourceName)
+}
+
+// preCheckSelfStorage skips the test if no bucket with the policy required by ACS is known to create the location in
+func preCheckSelfStorage(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("SELF_STORAGE_BUCKET_NAME") == "" {
+		t.Skip("`SELF_STORAGE_BUCKET_NAME` must be set for self storage location acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudSelfStorageLocation(t *testing.T) {
+	resourceName := resource.UniqueId()
+	bucketName := os.Getenv("SELF_STORAGE_BUCKET_NAME")
+
+	selfStorageLocationResourceTest := []resource.TestStep{
+		// Create self storage location
+		{
+			Config: testAccInstanceConfigSelfStorageLocation(resourceName, bucketName),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "bucket_name", bucketName),
+				resource.TestCheckResourceAttr(resourcePrefix(resourceName), "folder", resourceName),
+				resource.TestCheckResourceAttrSet(resourcePrefix(resourceName), "uri"),
+				resource.TestCheckResourceAttrSet(resourcePrefix(resourceName), "bucket_path"),
+			),
+		},
+		// Import self storage location
+		{
+			ResourceName:      resourcePrefix(resourceName),
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckSelfStorage(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps:             selfStorageLocationResourceTest,
+	})
+}
+
+func testAccInstanceConfigSelfStorageLocation(resourceName string, bucketName string) string {
+	return fmt.Sprintf(`resource "scp_self_storage_location" %[1]q {
+		bucket_name = %[2]q
+		folder      = %[1]q
+		title       = %[1]q
+		description = "terraform acceptance test"
+	}`, resourceName, bucketName)
+}
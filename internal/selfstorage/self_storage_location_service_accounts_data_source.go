@@ -0,0 +1,87 @@
+package selfstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	ServiceAccountsDataSourceKey = "scp_self_storage_location_service_accounts"
+
+	schemaKeyClusterMaster = "cluster_master"
+	schemaKeyIndexer       = "indexer"
+)
+
+func selfStorageLocationServiceAccountsDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyClusterMaster: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The service account of the cluster master that needs access to the bucket.",
+		},
+		schemaKeyIndexer: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The service account of the indexers that needs access to the bucket.",
+		},
+		schemaKeyMessage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Instructions from ACS on granting the service accounts access to the bucket.",
+		},
+	}
+}
+
+func DataSourceSelfStorageLocationServiceAccounts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Service Accounts Data Source. Use this data source to look up the service accounts " +
+			"of the stack that need access to a GCS bucket used as a self storage location. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations " +
+			"for more latest, detailed information on the ACS Self Storage Locations API.",
+
+		ReadContext: dataSourceSelfStorageLocationServiceAccountsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: selfStorageLocationServiceAccountsDataSourceSchema(),
+	}
+}
+
+func dataSourceSelfStorageLocationServiceAccountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	serviceAccounts, err := WaitSelfStorageLocationServiceAccountsRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading self storage location service accounts: %s", err)
+	}
+
+	var clusterMaster, indexer string
+	if serviceAccounts.ServiceAccounts != nil {
+		clusterMaster = serviceAccounts.ServiceAccounts.ClusterMaster
+		indexer = serviceAccounts.ServiceAccounts.Indexer
+	}
+
+	if err := d.Set(schemaKeyClusterMaster, clusterMaster); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyIndexer, indexer); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyMessage, serviceAccounts.Message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package selfstorage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// ListPageSize is the number of self storage locations requested per page when listing all locations
+const ListPageSize = 100
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// SelfStorageLocations is the response body of ListSelfStorageLocations
+type SelfStorageLocations struct {
+	SelfStorageLocations []v2.SelfStorageLocationInfo `json:"selfStorageLocations"`
+}
+
+// SelfStorageLocationStatusCreate returns StateRefreshFunc that makes POST request and checks if response is accepted
+func SelfStorageLocationStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createRequest v2.CreateSelfStorageLocationJSONRequestBody) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.CreateSelfStorageLocation(ctx, stack, createRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// SelfStorageLocationStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns self storage location response
+func SelfStorageLocationStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketPath string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeSelfStorageLocation(ctx, stack, v2.BucketPath(bucketPath))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var location v2.SelfStorageLocationInfo
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &location); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &location, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// SelfStorageLocationStatusVerifyCreate returns a StateRefreshFunc that lists all self storage locations and checks if a
+// location of the given bucket and folder has been created, in which case it is returned
+func SelfStorageLocationStatusVerifyCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketName string, folder string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		locations := &SelfStorageLocations{}
+		count := v2.Count(ListPageSize)
+		offset := v2.Offset(0)
+		for {
+			resp, err := acsClient.ListSelfStorageLocations(ctx, stack, &v2.ListSelfStorageLocationsParams{Count: &count, Offset: &offset})
+			if err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; ok {
+				return locations, http.StatusText(resp.StatusCode), nil
+			}
+			if resp.StatusCode != http.StatusOK {
+				return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+					State:         http.StatusText(resp.StatusCode),
+					ExpectedState: wait.TargetStatusResourceExists,
+					LastError:     errors.New(string(bodyBytes)),
+				}
+			}
+
+			var page SelfStorageLocations
+			if err = json.Unmarshal(bodyBytes, &page); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if location := FindSelfStorageLocation(page.SelfStorageLocations, bucketName, folder); location != nil {
+				return location, status.UpdatedStatus, nil
+			}
+			locations.SelfStorageLocations = append(locations.SelfStorageLocations, page.SelfStorageLocations...)
+
+			// a page that is not full is the last page
+			if len(page.SelfStorageLocations) < ListPageSize {
+				return locations, http.StatusText(resp.StatusCode), nil
+			}
+			offset += v2.Offset(len(page.SelfStorageLocations))
+		}
+	}
+}
+
+// FindSelfStorageLocation returns the self storage location of the given bucket and folder, or nil if there is none
+func FindSelfStorageLocation(locations []v2.SelfStorageLocationInfo, bucketName string, folder string) *v2.SelfStorageLocationInfo {
+	for i := range locations {
+		if locations[i].BucketName == bucketName && locations[i].Folder == folder {
+			return &locations[i]
+		}
+	}
+	return nil
+}
+
+// SelfStorageLocationPolicyStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the bucket policy response
+func SelfStorageLocationPolicyStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetSelfStorageLocationPolicy(ctx, stack, v2.BucketName(bucketName))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var policy v2.SelfStorageLocationPolicy
+		return status.ProcessReadResponse(resp, &policy)
+	}
+}
+
+// SelfStorageLocationPrefixStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the bucket prefix response
+func SelfStorageLocationPrefixStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetSelfStorageLocationPrefix(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var prefix v2.SelfStorageLocationPrefix
+		return status.ProcessReadResponse(resp, &prefix)
+	}
+}
+
+// SelfStorageLocationServiceAccountsStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the service accounts response
+func SelfStorageLocationServiceAccountsStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetSelfStorageLocationServiceAccounts(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var serviceAccounts v2.SelfStorageLocationServiceAccountsResponse
+		return status.ProcessReadResponse(resp, &serviceAccounts)
+	}
+}
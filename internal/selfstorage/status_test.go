@@ -0,0 +1,88 @@
+package selfstorage_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/selfstorage"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_SelfStorageLocationStatusVerifyCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	other := v2.SelfStorageLocationInfo{BucketName: mockBucketName, BucketPath: "s3://mock-stack-ddss-bucket", Title: "root"}
+
+	t.Run("with location not listed yet", func(t *testing.T) {
+		client.On("ListSelfStorageLocations", mock.Anything, v2.Stack(mockStack), genListParams(0)).Return(genListResp(http.StatusOK, []v2.SelfStorageLocationInfo{other}), nil).Once()
+		location, statusText, err := selfstorage.SelfStorageLocationStatusVerifyCreate(context.TODO(), client, mockStack, mockBucketName, mockFolder)()
+		assert.NoError(t, err)
+		assert.NotNil(t, location)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+
+	t.Run("with location listed on a later page", func(t *testing.T) {
+		firstPage := make([]v2.SelfStorageLocationInfo, 0, selfstorage.ListPageSize)
+		for i := 0; i < selfstorage.ListPageSize; i++ {
+			firstPage = append(firstPage, v2.SelfStorageLocationInfo{BucketName: mockBucketName, Folder: fmt.Sprint("folder", i)})
+		}
+		client.On("ListSelfStorageLocations", mock.Anything, v2.Stack(mockStack), genListParams(0)).Return(genListResp(http.StatusOK, firstPage), nil).Once()
+		client.On("ListSelfStorageLocations", mock.Anything, v2.Stack(mockStack), genListParams(selfstorage.ListPageSize)).Return(genListResp(http.StatusOK, []v2.SelfStorageLocationInfo{mockLocation}), nil).Once()
+		location, statusText, err := selfstorage.SelfStorageLocationStatusVerifyCreate(context.TODO(), client, mockStack, mockBucketName, mockFolder)()
+		assert.NoError(t, err)
+		assert.Equal(t, &mockLocation, location)
+		assert.Equal(t, status.UpdatedStatus, statusText)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("ListSelfStorageLocations", mock.Anything, v2.Stack(mockStack), genListParams(0)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		location, statusText, err := selfstorage.SelfStorageLocationStatusVerifyCreate(context.TODO(), client, mockStack, mockBucketName, mockFolder)()
+		assert.NoError(t, err)
+		assert.NotNil(t, location)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+}
+
+func Test_FindSelfStorageLocation(t *testing.T) {
+	root := v2.SelfStorageLocationInfo{BucketName: mockBucketName, BucketPath: "s3://mock-stack-ddss-bucket"}
+	locations := []v2.SelfStorageLocationInfo{root, mockLocation}
+
+	assert.Equal(t, &mockLocation, selfstorage.FindSelfStorageLocation(locations, mockBucketName, mockFolder))
+	assert.Equal(t, &root, selfstorage.FindSelfStorageLocation(locations, mockBucketName, ""))
+	assert.Nil(t, selfstorage.FindSelfStorageLocation(locations, "other-bucket", mockFolder))
+}
+
+func genListParams(offset int) *v2.ListSelfStorageLocationsParams {
+	count := v2.Count(selfstorage.ListPageSize)
+	parsedOffset := v2.Offset(offset)
+	return &v2.ListSelfStorageLocationsParams{Count: &count, Offset: &parsedOffset}
+}
+
+func genListResp(statusCode int, locations []v2.SelfStorageLocationInfo) *http.Response {
+	b, _ := json.Marshal(selfstorage.SelfStorageLocations{SelfStorageLocations: locations})
+	return genRawResp(statusCode, string(b))
+}
+
+func genLocationResp(statusCode int, location v2.SelfStorageLocationInfo) *http.Response {
+	return genJSONResp(statusCode, location)
+}
+
+func genJSONResp(statusCode int, body interface{}) *http.Response {
+	b, _ := json.Marshal(body)
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
@@ -0,0 +1,107 @@
+package selfstorage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// Self storage locations are listed until the created location shows up
+	PendingStatusVerifyCreated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitSelfStorageLocationCreate Handles retry logic for POST requests for create lifecycle function
+func WaitSelfStorageLocationCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createRequest v2.CreateSelfStorageLocationJSONRequestBody) error {
+	waitSelfStorageLocationCreateAccepted := wait.GenerateWriteStateChangeConf(SelfStorageLocationStatusCreate(ctx, acsClient, stack, createRequest))
+
+	rawResp, err := waitSelfStorageLocationCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for self storage location (%s) to be created: %s", createRequest.BucketName, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and creation in progress
+	tflog.Info(ctx, fmt.Sprintf("Create response status code for self storage location (%s): %d\n", createRequest.BucketName, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for self storage location (%s): %s\n", createRequest.BucketName, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifySelfStorageLocationCreate waits until a self storage location of the given bucket and folder is listed and returns it
+func WaitVerifySelfStorageLocationCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketName string, folder string) (*v2.SelfStorageLocationInfo, error) {
+	waitSelfStorageLocationCreated := wait.GenerateReadStateChangeConf(PendingStatusVerifyCreated, []string{status.UpdatedStatus}, SelfStorageLocationStatusVerifyCreate(ctx, acsClient, stack, bucketName, folder))
+
+	output, err := waitSelfStorageLocationCreated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for self storage location (%s) to be created: %s", bucketName, err))
+		return nil, err
+	}
+	location := output.(*v2.SelfStorageLocationInfo)
+
+	return location, nil
+}
+
+// WaitSelfStorageLocationRead Handles retry logic for GET requests for the read lifecycle function
+func WaitSelfStorageLocationRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketPath string) (*v2.SelfStorageLocationInfo, error) {
+	waitSelfStorageLocationRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, SelfStorageLocationStatusRead(ctx, acsClient, stack, bucketPath))
+
+	output, err := waitSelfStorageLocationRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location (%s): %s", bucketPath, err))
+		return nil, err
+	}
+	location := output.(*v2.SelfStorageLocationInfo)
+
+	return location, nil
+}
+
+// WaitSelfStorageLocationPolicyRead Handles retry logic for GET requests reading the bucket policy required for a self storage location
+func WaitSelfStorageLocationPolicyRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketName string) (*v2.SelfStorageLocationPolicy, error) {
+	waitSelfStorageLocationPolicyRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, SelfStorageLocationPolicyStatusRead(ctx, acsClient, stack, bucketName))
+
+	output, err := waitSelfStorageLocationPolicyRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location policy for bucket (%s): %s", bucketName, err))
+		return nil, err
+	}
+	policy := output.(*v2.SelfStorageLocationPolicy)
+
+	return policy, nil
+}
+
+// WaitSelfStorageLocationPrefixRead Handles retry logic for GET requests reading the bucket name prefix required for a self storage location
+func WaitSelfStorageLocationPrefixRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.SelfStorageLocationPrefix, error) {
+	waitSelfStorageLocationPrefixRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, SelfStorageLocationPrefixStatusRead(ctx, acsClient, stack))
+
+	output, err := waitSelfStorageLocationPrefixRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location prefix: %s", err))
+		return nil, err
+	}
+	prefix := output.(*v2.SelfStorageLocationPrefix)
+
+	return prefix, nil
+}
+
+// WaitSelfStorageLocationServiceAccountsRead Handles retry logic for GET requests reading the service accounts that need access to a
+// self storage location bucket
+func WaitSelfStorageLocationServiceAccountsRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.SelfStorageLocationServiceAccountsResponse, error) {
+	waitSelfStorageLocationServiceAccountsRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, SelfStorageLocationServiceAccountsStatusRead(ctx, acsClient, stack))
+
+	output, err := waitSelfStorageLocationServiceAccountsRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location service accounts: %s", err))
+		return nil, err
+	}
+	serviceAccounts := output.(*v2.SelfStorageLocationServiceAccountsResponse)
+
+	return serviceAccounts, nil
+}
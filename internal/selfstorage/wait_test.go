@@ -0,0 +1,220 @@
+package selfstorage_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/selfstorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack      = "mock-stack"
+	mockBucketName = "mock-stack-ddss-bucket"
+	mockFolder     = "archive"
+	mockBucketPath = "s3://mock-stack-ddss-bucket/archive"
+)
+
+var (
+	unexpectedStatusCodes = []int{400, 401, 403, 404, 501, 503}
+
+	mockLocation = v2.SelfStorageLocationInfo{
+		BucketName:  mockBucketName,
+		BucketPath:  mockBucketPath,
+		Description: "archived indexes",
+		Folder:      mockFolder,
+		Title:       "archive",
+		Uri:         "https://mock-stack-ddss-bucket.s3.amazonaws.com/archive",
+	}
+
+	mockCreateBody = v2.CreateSelfStorageLocationJSONRequestBody{
+		BucketName: mockBucketName,
+		Folder:     &mockLocation.Folder,
+		Title:      mockLocation.Title,
+	}
+)
+
+func Test_WaitSelfStorageLocationCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(nil, errors.New("some error")).Once()
+		err := selfstorage.WaitSelfStorageLocationCreate(context.TODO(), client, mockStack, mockCreateBody)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genLocationResp(http.StatusAccepted, mockLocation), nil).Once()
+		err := selfstorage.WaitSelfStorageLocationCreate(context.TODO(), client, mockStack, mockCreateBody)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genLocationResp(http.StatusAccepted, mockLocation), nil).Once()
+		err := selfstorage.WaitSelfStorageLocationCreate(context.TODO(), client, mockStack, mockCreateBody)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(unexpectedStatusCodes, http.StatusConflict) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genRawResp(statusCode, ""), nil).Once()
+				err := selfstorage.WaitSelfStorageLocationCreate(context.TODO(), client, mockStack, mockCreateBody)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifySelfStorageLocationCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with location listed after retry", func(t *testing.T) {
+		client.On("ListSelfStorageLocations", mock.Anything, v2.Stack(mockStack), genListParams(0)).Return(genListResp(http.StatusOK, nil), nil).Once()
+		client.On("ListSelfStorageLocations", mock.Anything, v2.Stack(mockStack), genListParams(0)).Return(genListResp(http.StatusOK, []v2.SelfStorageLocationInfo{mockLocation}), nil).Once()
+		location, err := selfstorage.WaitVerifySelfStorageLocationCreate(context.TODO(), client, mockStack, mockBucketName, mockFolder)
+		assert.NoError(t, err)
+		assert.Equal(t, mockLocation, *location)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ListSelfStorageLocations", mock.Anything, v2.Stack(mockStack), genListParams(0)).Return(genRawResp(statusCode, ""), nil).Once()
+				location, err := selfstorage.WaitVerifySelfStorageLocationCreate(context.TODO(), client, mockStack, mockBucketName, mockFolder)
+				assert.Error(t, err)
+				assert.Nil(t, location)
+			})
+		}
+	})
+}
+
+func Test_WaitSelfStorageLocationRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(nil, errors.New("some error")).Once()
+		location, err := selfstorage.WaitSelfStorageLocationRead(context.TODO(), client, mockStack, mockBucketPath)
+		assert.Error(t, err)
+		assert.Nil(t, location)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(http.StatusOK, mockLocation), nil).Once()
+		location, err := selfstorage.WaitSelfStorageLocationRead(context.TODO(), client, mockStack, mockBucketPath)
+		assert.NoError(t, err)
+		assert.Equal(t, mockLocation, *location)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(http.StatusOK, mockLocation), nil).Once()
+		location, err := selfstorage.WaitSelfStorageLocationRead(context.TODO(), client, mockStack, mockBucketPath)
+		assert.NoError(t, err)
+		assert.Equal(t, mockLocation, *location)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genRawResp(statusCode, ""), nil).Once()
+				location, err := selfstorage.WaitSelfStorageLocationRead(context.TODO(), client, mockStack, mockBucketPath)
+				assert.Error(t, err)
+				assert.Nil(t, location)
+			})
+		}
+	})
+}
+
+func Test_WaitSelfStorageLocationPolicyRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	policy := v2.SelfStorageLocationPolicy{
+		Message: "apply the policy to the bucket",
+		Policy:  map[string]interface{}{"Version": "2012-10-17"},
+	}
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genJSONResp(http.StatusOK, policy), nil).Once()
+		output, err := selfstorage.WaitSelfStorageLocationPolicyRead(context.TODO(), client, mockStack, mockBucketName)
+		assert.NoError(t, err)
+		assert.Equal(t, policy, *output)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genJSONResp(http.StatusOK, policy), nil).Once()
+		output, err := selfstorage.WaitSelfStorageLocationPolicyRead(context.TODO(), client, mockStack, mockBucketName)
+		assert.NoError(t, err)
+		assert.Equal(t, policy, *output)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genRawResp(statusCode, ""), nil).Once()
+				output, err := selfstorage.WaitSelfStorageLocationPolicyRead(context.TODO(), client, mockStack, mockBucketName)
+				assert.Error(t, err)
+				assert.Nil(t, output)
+			})
+		}
+	})
+}
+
+func Test_WaitSelfStorageLocationPrefixRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	prefix := v2.SelfStorageLocationPrefix{Message: "bucket names must start with the prefix", Prefix: "mock-stack-"}
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetSelfStorageLocationPrefix", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, prefix), nil).Once()
+		output, err := selfstorage.WaitSelfStorageLocationPrefixRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, prefix, *output)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetSelfStorageLocationPrefix", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				output, err := selfstorage.WaitSelfStorageLocationPrefixRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, output)
+			})
+		}
+	})
+}
+
+func Test_WaitSelfStorageLocationServiceAccountsRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	serviceAccounts := v2.SelfStorageLocationServiceAccountsResponse{
+		Message: "grant the service accounts access to the bucket",
+		ServiceAccounts: &v2.SelfStorageLocationServiceAccounts{
+			ClusterMaster: "cm@mock-stack.iam.gserviceaccount.com",
+			Indexer:       "idx@mock-stack.iam.gserviceaccount.com",
+		},
+	}
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetSelfStorageLocationServiceAccounts", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, serviceAccounts), nil).Once()
+		output, err := selfstorage.WaitSelfStorageLocationServiceAccountsRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, serviceAccounts, *output)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetSelfStorageLocationServiceAccounts", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				output, err := selfstorage.WaitSelfStorageLocationServiceAccountsRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, output)
+			})
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	PythonVersionResourceKey = "scp_python_version"
+
+	schemaKeyPythonVersion = "python_version"
+	schemaKeyMessage       = "message"
+)
+
+func pythonVersionResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyPythonVersion: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The python version of the stack, e.g. 3.9.",
+		},
+		schemaKeyMessage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Any message returned by ACS about the python version of the stack.",
+		},
+	}
+}
+
+func ResourcePythonVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Python Version Resource. Use this resource to set the python version of a stack, so that changing it " +
+			"can be reviewed together with the app upgrades depending on it. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManagePythonVersion " +
+			"for more latest, detailed information on attribute requirements and the ACS Python Version API.",
+
+		CreateContext: resourcePythonVersionCreate,
+		ReadContext:   resourcePythonVersionRead,
+		UpdateContext: resourcePythonVersionUpdate,
+		DeleteContext: resourcePythonVersionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: pythonVersionResourceSchema(),
+	}
+}
+
+func resourcePythonVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	pythonVersion := d.Get(schemaKeyPythonVersion).(string)
+
+	// The version is only changed if the stack is not on it already, as changing it may restart the stack
+	current, err := WaitPythonVersionRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading python version (%s): %s", stack, err)
+	}
+	if stringValue(current.PythonVersion) != pythonVersion {
+		if diags := changePythonVersion(ctx, d, m); diags != nil {
+			return diags
+		}
+	}
+
+	// There is a single python version per stack, the stack is used as ID of the resource
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created python version resource: %s\n", pythonVersion))
+
+	return resourcePythonVersionRead(ctx, d, m)
+}
+
+func resourcePythonVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	pythonVersion, err := WaitPythonVersionRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading python version (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(schemaKeyPythonVersion, stringValue(pythonVersion.PythonVersion)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyMessage, stringValue(pythonVersion.Message)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePythonVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange(schemaKeyPythonVersion) {
+		if diags := changePythonVersion(ctx, d, m); diags != nil {
+			return diags
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated python version resource: %s\n", d.Get(schemaKeyPythonVersion).(string)))
+	}
+
+	return resourcePythonVersionRead(ctx, d, m)
+}
+
+func resourcePythonVersionDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The stack always has a python version, the version is left unchanged and only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("Python version of stack (%s) is left unchanged, removing it from state only.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// changePythonVersion changes the python version of the stack and waits until it is applied and any required restart has completed
+func changePythonVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	pythonVersion := d.Get(schemaKeyPythonVersion).(string)
+
+	if err := WaitPythonVersionUpdate(ctx, acsClient, stack, pythonVersion); err != nil {
+		return diag.Errorf("Error submitting request for python version (%s) to be changed: %s", pythonVersion, err)
+	}
+
+	//Poll until the python version has been changed
+	if err := WaitVerifyPythonVersionUpdate(ctx, acsClient, stack, pythonVersion); err != nil {
+		return diag.Errorf("Error waiting for python version (%s) to be changed: %s", pythonVersion, err)
+	}
+
+	// The stack is only restarted if ACS reports a restart as required after the change
+	stackInfo, err := WaitStackRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading stack (%s): %s", stack, err)
+	}
+	if !IsRestartRequired(stackInfo) {
+		return nil
+	}
+
+	//Poll until the required restart has been initiated and has completed
+	if _, err := WaitVerifyRequestedRestartComplete(ctx, acsClient, stack, true); err != nil {
+		return diag.Errorf("Error waiting for restart after changing python version (%s): %s", pythonVersion, err)
+	}
+
+	return nil
+}
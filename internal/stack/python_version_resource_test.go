@@ -0,0 +1,49 @@
+package stack_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+// preCheckPythonVersion skips the test if no python version is known to set the stack to
+func preCheckPythonVersion(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("PYTHON_VERSION") == "" {
+		t.Skip("`PYTHON_VERSION` must be set for python version acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudPythonVersion(t *testing.T) {
+	pythonVersion := os.Getenv("PYTHON_VERSION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckPythonVersion(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			// Set python version
+			{
+				Config: testAccInstanceConfigPythonVersion(pythonVersion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scp_python_version.test", "python_version", pythonVersion),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "scp_python_version.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceConfigPythonVersion(pythonVersion string) string {
+	return fmt.Sprintf(`
+	resource "scp_python_version" "test" {
+		python_version = %q
+	}`, pythonVersion)
+}
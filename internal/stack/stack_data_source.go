@@ -0,0 +1,98 @@
+package stack
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKey = "scp_stack"
+
+	schemaKeyStackType            = "stack_type"
+	schemaKeyStackVersion         = "stack_version"
+	schemaKeyInfrastructureStatus = "infrastructure_status"
+)
+
+func stackDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyStackType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the stack, either Victoria or Classic.",
+		},
+		schemaKeyStackVersion: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Splunk version of the stack.",
+		},
+		schemaKeyInfrastructureStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the infrastructure of the stack. Ready if the infrastructure is up to date, Pending if changes have not been applied yet, or Failed if applying changes failed.",
+		},
+		schemaKeyRestartRequired: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the stack has a notification to restart for all configurations to be completed.",
+		},
+	}
+}
+
+func DataSourceStack() *schema.Resource {
+	return &schema.Resource{
+		Description: "Stack Data Source. Use this data source to read the type, version and readiness of a stack, for example " +
+			"to branch on Victoria or Classic stacks or to fail a precondition while the infrastructure has failed. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ACSIntro " +
+			"for more latest, detailed information on the ACS Stack Status API.",
+
+		ReadContext: dataSourceStackRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: stackDataSourceSchema(),
+	}
+}
+
+func dataSourceStackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stackInfo, err := WaitStackRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading stack (%s): %s", stack, err)
+	}
+
+	var stackType, stackVersion, infrastructureStatus string
+	if stackInfo.Status != nil {
+		stackType = stringValue(stackInfo.Status.Infrastructure.StackType)
+		stackVersion = stringValue(stackInfo.Status.Infrastructure.StackVersion)
+		infrastructureStatus = stringValue(stackInfo.Status.Infrastructure.Status)
+	}
+
+	if err := d.Set(schemaKeyStackType, stackType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStackVersion, stackVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyInfrastructureStatus, infrastructureStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRestartRequired, IsRestartRequired(stackInfo)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
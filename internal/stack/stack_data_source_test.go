@@ -0,0 +1,33 @@
+package stack_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+func TestAcc_SplunkCloudStackDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigStackDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.scp_stack.test", "stack_type", regexp.MustCompile("(?i)^(victoria|classic)$")),
+					resource.TestCheckResourceAttrSet("data.scp_stack.test", "stack_version"),
+					resource.TestCheckResourceAttrSet("data.scp_stack.test", "infrastructure_status"),
+					resource.TestCheckResourceAttrSet("data.scp_stack.test", "restart_required"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceConfigStackDataSource() string {
+	return `
+	data "scp_stack" "test" {
+	}`
+}
@@ -0,0 +1,138 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	RestartResourceKey = "scp_stack_restart"
+
+	schemaKeyTriggers        = "triggers"
+	schemaKeyForce           = "force"
+	schemaKeyRestartRequired = "restart_required"
+	schemaKeyRestarted       = "restarted"
+	schemaKeyCaptain         = "captain"
+)
+
+func stackRestartResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyTriggers: {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "A map of arbitrary strings that, when changed, restarts the stack if a restart is required.",
+		},
+		schemaKeyForce: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Set to true to restart the stack even if ACS does not report a restart as required. Defaults to false.",
+		},
+		schemaKeyRestartRequired: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if ACS reported a restart as required when the triggers last changed.",
+		},
+		schemaKeyRestarted: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the stack was restarted when the triggers last changed.",
+		},
+		schemaKeyCaptain: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The search head cluster captain after the restart, empty if the stack was not restarted or has no search head cluster.",
+		},
+	}
+}
+
+func ResourceStackRestart() *schema.Resource {
+	return &schema.Resource{
+		Description: "Stack Restart Resource. Use this resource to restart a stack when its triggers change and ACS reports " +
+			"a restart as required, for example after installing apps or changing limits. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/RestartSplunkCloud " +
+			"for more latest, detailed information on the ACS Restart API.",
+
+		CreateContext: resourceStackRestartCreate,
+		ReadContext:   resourceStackRestartRead,
+		UpdateContext: resourceStackRestartUpdate,
+		DeleteContext: resourceStackRestartDelete,
+
+		Schema: stackRestartResourceSchema(),
+	}
+}
+
+func resourceStackRestartCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stackInfo, err := WaitStackRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading stack (%s): %s", stack, err)
+	}
+	restartRequired := IsRestartRequired(stackInfo)
+
+	// Every change of the triggers creates a new restart, which is identified by a unique ID
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", stack)))
+
+	if err := d.Set(schemaKeyRestartRequired, restartRequired); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !restartRequired && !d.Get(schemaKeyForce).(bool) {
+		tflog.Info(ctx, fmt.Sprintf("Skipping restart of stack (%s), no restart is required.\n", stack))
+		if err := d.Set(schemaKeyRestarted, false); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	if err := WaitRestartCreate(ctx, acsClient, stack); err != nil {
+		return diag.Errorf("Error submitting request for stack (%s) to be restarted: %s", stack, err)
+	}
+
+	//Poll until the rolling restart has been initiated, then until it has completed and every search head is ready
+	restartStatuses, err := WaitVerifyRequestedRestartComplete(ctx, acsClient, stack, restartRequired)
+	if err != nil {
+		return diag.Errorf("Error waiting for restart of stack (%s) to complete: %s", stack, err)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Restarted stack: %s\n", stack))
+
+	if err := d.Set(schemaKeyRestarted, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyCaptain, RestartCaptain(restartStatuses.ShcStatus)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStackRestartRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A restart is an action that has no state to read back
+	return nil
+}
+
+func resourceStackRestartUpdate(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Only force can be updated in place, it takes effect on the next change of the triggers
+	return nil
+}
+
+func resourceStackRestartDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A restart can not be undone, the resource is only removed from state
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,52 @@
+package stack_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+// preCheckStackRestart skips the test unless restarting the test stack has been allowed
+func preCheckStackRestart(t *testing.T) {
+	acctest.PreCheck(t)
+	if os.Getenv("STACK_RESTART_ALLOWED") == "" {
+		t.Skip("`STACK_RESTART_ALLOWED` must be set for stack restart acceptance tests")
+	}
+}
+
+func TestAcc_SplunkCloudStackRestart(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { preCheckStackRestart(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			// Restart only if required
+			{
+				Config: testAccInstanceConfigStackRestart("1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("scp_stack_restart.test", "restart_required"),
+					resource.TestCheckResourceAttrSet("scp_stack_restart.test", "restarted"),
+				),
+			},
+			// Changing the triggers with force restarts the stack
+			{
+				Config: testAccInstanceConfigStackRestart("2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scp_stack_restart.test", "restarted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceConfigStackRestart(revision string, force bool) string {
+	return fmt.Sprintf(`
+	resource "scp_stack_restart" "test" {
+		triggers = {
+			revision = %q
+		}
+		force = %t
+	}`, revision, force)
+}
@@ -0,0 +1,196 @@
+package stack
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// RestartStatuses is the response body of RestartStatus
+type RestartStatuses struct {
+	ShcStatus []v2.RestartStatus `json:"shcStatus"`
+}
+
+// StackInfo is the response body of DescribeStack
+type StackInfo struct {
+	Status *v2.StackStatus `json:"status,omitempty"`
+}
+
+// StackStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the stack status response
+func StackStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeStack(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var stackInfo StackInfo
+		return status.ProcessReadResponse(resp, &stackInfo)
+	}
+}
+
+// PythonVersionStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the python version response
+func PythonVersionStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetPythonVersion(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var pythonVersion v2.PythonVersionResponse
+		return status.ProcessReadResponse(resp, &pythonVersion)
+	}
+}
+
+// PythonVersionStatusUpdate returns StateRefreshFunc that makes POST request and checks if response is accepted
+func PythonVersionStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pythonVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.ChangePythonVersion(ctx, stack, v2.ChangePythonVersionJSONRequestBody{PythonVersion: &pythonVersion})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// PythonVersionStatusVerifyUpdate returns a StateRefreshFunc that makes a GET request and checks if the python version of the
+// stack has been changed to the given version
+func PythonVersionStatusVerifyUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pythonVersion string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := PythonVersionStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+		response := output.(*v2.PythonVersionResponse)
+
+		if stringValue(response.PythonVersion) != pythonVersion {
+			return response, statusText, nil
+		}
+		return response, status.UpdatedStatus, nil
+	}
+}
+
+// RestartStatusCreate returns StateRefreshFunc that makes POST request to restart the stack and checks if response is accepted
+func RestartStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.RestartStack(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// RestartStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the restart
+// status of every search head of the stack
+func RestartStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.RestartStatus(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var restartStatuses RestartStatuses
+		return status.ProcessReadResponse(resp, &restartStatuses)
+	}
+}
+
+// RestartStatusVerifyComplete returns a StateRefreshFunc that makes a GET request and checks if no rolling restart is in
+// progress and every search head is ready to serve requests
+func RestartStatusVerifyComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := RestartStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+		restartStatuses := output.(*RestartStatuses)
+
+		if !IsRestartComplete(restartStatuses.ShcStatus) {
+			return restartStatuses, statusText, nil
+		}
+		return restartStatuses, status.UpdatedStatus, nil
+	}
+}
+
+// RestartStatusVerifyInitiated returns a StateRefreshFunc that makes GET requests and checks if a rolling restart has been
+// initiated on any search head or, if the stack required a restart, the stack no longer reports a restart as required
+func RestartStatusVerifyInitiated(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, restartRequired bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := RestartStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+		restartStatuses := output.(*RestartStatuses)
+
+		if IsRestartInitiated(restartStatuses.ShcStatus) {
+			return restartStatuses, status.UpdatedStatus, nil
+		}
+		if !restartRequired {
+			return restartStatuses, statusText, nil
+		}
+
+		output, statusText, err = StackStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+		if IsRestartRequired(output.(*StackInfo)) {
+			return restartStatuses, statusText, nil
+		}
+		return restartStatuses, status.UpdatedStatus, nil
+	}
+}
+
+// IsRestartInitiated returns true if any search head has a rolling restart in progress
+func IsRestartInitiated(restartStatuses []v2.RestartStatus) bool {
+	for _, restartStatus := range restartStatuses {
+		if boolValue(restartStatus.RollingRestartInitiated) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRestartComplete returns true if no search head has a rolling restart in progress and every search head is ready
+func IsRestartComplete(restartStatuses []v2.RestartStatus) bool {
+	for _, restartStatus := range restartStatuses {
+		if boolValue(restartStatus.RollingRestartInitiated) || !boolValue(restartStatus.ServiceReady) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsRestartRequired returns true if the stack has a notification to restart
+func IsRestartRequired(stackInfo *StackInfo) bool {
+	return stackInfo.Status != nil && boolValue(stackInfo.Status.Messages.RestartRequired)
+}
+
+// RestartCaptain returns the captain reported by the restart status of the search heads, or an empty string if there is none
+func RestartCaptain(restartStatuses []v2.RestartStatus) string {
+	for _, restartStatus := range restartStatuses {
+		if captain := stringValue(restartStatus.Captain); captain != "" {
+			return captain
+		}
+	}
+	return ""
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
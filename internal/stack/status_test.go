@@ -0,0 +1,91 @@
+package stack_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/stack"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsRestartComplete(t *testing.T) {
+	t.Run("with every search head ready", func(t *testing.T) {
+		assert.True(t, stack.IsRestartComplete([]v2.RestartStatus{genRestartStatus(false, true), genRestartStatus(false, true)}))
+	})
+
+	t.Run("with no search head cluster", func(t *testing.T) {
+		assert.True(t, stack.IsRestartComplete(nil))
+	})
+
+	t.Run("with rolling restart in progress", func(t *testing.T) {
+		assert.False(t, stack.IsRestartComplete([]v2.RestartStatus{genRestartStatus(true, true), genRestartStatus(false, true)}))
+	})
+
+	t.Run("with search head not ready", func(t *testing.T) {
+		assert.False(t, stack.IsRestartComplete([]v2.RestartStatus{genRestartStatus(false, true), genRestartStatus(false, false)}))
+	})
+
+	t.Run("with unknown status", func(t *testing.T) {
+		assert.False(t, stack.IsRestartComplete([]v2.RestartStatus{{}}))
+	})
+}
+
+func Test_IsRestartInitiated(t *testing.T) {
+	assert.True(t, stack.IsRestartInitiated([]v2.RestartStatus{genRestartStatus(false, true), genRestartStatus(true, false)}))
+	assert.False(t, stack.IsRestartInitiated([]v2.RestartStatus{genRestartStatus(false, true), genRestartStatus(false, false)}))
+	assert.False(t, stack.IsRestartInitiated(nil))
+}
+
+func Test_IsRestartRequired(t *testing.T) {
+	required, notRequired := true, false
+
+	t.Run("with restart required", func(t *testing.T) {
+		assert.True(t, stack.IsRestartRequired(genStackInfo(&required)))
+	})
+
+	t.Run("with restart not required", func(t *testing.T) {
+		assert.False(t, stack.IsRestartRequired(genStackInfo(&notRequired)))
+	})
+
+	t.Run("with no restart message", func(t *testing.T) {
+		assert.False(t, stack.IsRestartRequired(genStackInfo(nil)))
+		assert.False(t, stack.IsRestartRequired(&stack.StackInfo{}))
+	})
+}
+
+func Test_RestartCaptain(t *testing.T) {
+	t.Run("with captain", func(t *testing.T) {
+		assert.Equal(t, "sh-i-0123456789", stack.RestartCaptain([]v2.RestartStatus{{}, genRestartStatus(false, true)}))
+	})
+
+	t.Run("with no search head cluster", func(t *testing.T) {
+		assert.Equal(t, "", stack.RestartCaptain(nil))
+	})
+}
+
+func genStackInfo(restartRequired *bool) *stack.StackInfo {
+	stackStatus := &v2.StackStatus{}
+	stackStatus.Messages.RestartRequired = restartRequired
+	return &stack.StackInfo{Status: stackStatus}
+}
+
+func genRestartStatus(rollingRestartInitiated bool, serviceReady bool) v2.RestartStatus {
+	captain := "sh-i-0123456789"
+	return v2.RestartStatus{Captain: &captain, RollingRestartInitiated: &rollingRestartInitiated, ServiceReady: &serviceReady}
+}
+
+func genJSONResp(statusCode int, body interface{}) *http.Response {
+	b, _ := json.Marshal(body)
+	return genRawResp(statusCode, string(b))
+}
+
+func genRawResp(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
@@ -0,0 +1,134 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// The python version and restart status are read until the change has been applied
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitStackRead Handles retry logic for GET requests reading the status of the stack
+func WaitStackRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*StackInfo, error) {
+	waitStackRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, StackStatusRead(ctx, acsClient, stack))
+
+	output, err := waitStackRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading stack (%s): %s", stack, err))
+		return nil, err
+	}
+	stackInfo := output.(*StackInfo)
+
+	return stackInfo, nil
+}
+
+// WaitPythonVersionRead Handles retry logic for GET requests for the read lifecycle function
+func WaitPythonVersionRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.PythonVersionResponse, error) {
+	waitPythonVersionRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PythonVersionStatusRead(ctx, acsClient, stack))
+
+	output, err := waitPythonVersionRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading python version: %s", err))
+		return nil, err
+	}
+	pythonVersion := output.(*v2.PythonVersionResponse)
+
+	return pythonVersion, nil
+}
+
+// WaitPythonVersionUpdate Handles retry logic for POST requests for the create and update lifecycle functions
+func WaitPythonVersionUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pythonVersion string) error {
+	waitPythonVersionUpdateAccepted := wait.GenerateWriteStateChangeConf(PythonVersionStatusUpdate(ctx, acsClient, stack, pythonVersion))
+
+	rawResp, err := waitPythonVersionUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for python version (%s) to be changed: %s", pythonVersion, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for python version (%s): %d\n", pythonVersion, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for python version (%s): %s\n", pythonVersion, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyPythonVersionUpdate waits until the python version of the stack has been changed to the given version
+func WaitVerifyPythonVersionUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pythonVersion string) error {
+	waitPythonVersionUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, PythonVersionStatusVerifyUpdate(ctx, acsClient, stack, pythonVersion))
+
+	_, err := waitPythonVersionUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for python version (%s) to be changed: %s", pythonVersion, err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitRestartCreate Handles retry logic for POST requests restarting the stack
+func WaitRestartCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) error {
+	waitRestartAccepted := wait.GenerateWriteStateChangeConf(RestartStatusCreate(ctx, acsClient, stack))
+
+	rawResp, err := waitRestartAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for stack (%s) to be restarted: %s", stack, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and restart in progress
+	tflog.Info(ctx, fmt.Sprintf("Restart response status code for stack (%s): %d\n", stack, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for stack (%s) restart: %s\n", stack, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitRestartStatusRead Handles retry logic for GET requests reading the restart status of the stack
+func WaitRestartStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*RestartStatuses, error) {
+	waitRestartStatusRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, RestartStatusRead(ctx, acsClient, stack))
+
+	output, err := waitRestartStatusRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading restart status: %s", err))
+		return nil, err
+	}
+	restartStatuses := output.(*RestartStatuses)
+
+	return restartStatuses, nil
+}
+
+// WaitVerifyRequestedRestartComplete waits until a requested rolling restart of the stack has been initiated, or the
+// required restart is no longer reported, and then until every search head is ready. Returns the restart status of the
+// search heads. Waiting for the restart to be initiated first keeps a restart that ACS has not started yet from being
+// mistaken for a completed one.
+func WaitVerifyRequestedRestartComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, restartRequired bool) (*RestartStatuses, error) {
+	waitRestartInitiated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, RestartStatusVerifyInitiated(ctx, acsClient, stack, restartRequired))
+
+	if _, err := waitRestartInitiated.WaitForStateContext(ctx); err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for restart of stack (%s) to be initiated: %s", stack, err))
+		return nil, err
+	}
+
+	waitRestartComplete := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, RestartStatusVerifyComplete(ctx, acsClient, stack))
+
+	output, err := waitRestartComplete.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for restart of stack (%s) to complete: %s", stack, err))
+		return nil, err
+	}
+	restartStatuses := output.(*RestartStatuses)
+
+	return restartStatuses, nil
+}
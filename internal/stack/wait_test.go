@@ -0,0 +1,219 @@
+package stack_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/stack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack         = "mock-stack"
+	mockPythonVersion = "3.9"
+	mockOldVersion    = "3.7"
+)
+
+var (
+	unexpectedStatusCodes = []int{400, 401, 403, 404, 501, 503}
+)
+
+func Test_WaitPythonVersionRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	pythonVersion := mockPythonVersion
+	mockResponse := v2.PythonVersionResponse{PythonVersion: &pythonVersion}
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, mockResponse), nil).Once()
+		response, err := stack.WaitPythonVersionRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, mockResponse, *response)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, mockResponse), nil).Once()
+		response, err := stack.WaitPythonVersionRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, mockResponse, *response)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				response, err := stack.WaitPythonVersionRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, response)
+			})
+		}
+	})
+}
+
+func Test_WaitPythonVersionUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	pythonVersion := mockPythonVersion
+	body := v2.ChangePythonVersionJSONRequestBody{PythonVersion: &pythonVersion}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), body).Return(nil, errors.New("some error")).Once()
+		err := stack.WaitPythonVersionUpdate(context.TODO(), client, mockStack, mockPythonVersion)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := stack.WaitPythonVersionUpdate(context.TODO(), client, mockStack, mockPythonVersion)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := stack.WaitPythonVersionUpdate(context.TODO(), client, mockStack, mockPythonVersion)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), body).Return(genRawResp(statusCode, ""), nil).Once()
+				err := stack.WaitPythonVersionUpdate(context.TODO(), client, mockStack, mockPythonVersion)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyPythonVersionUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	oldVersion, newVersion := mockOldVersion, mockPythonVersion
+
+	t.Run("with version changed after polling", func(t *testing.T) {
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, v2.PythonVersionResponse{PythonVersion: &oldVersion}), nil).Once()
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, v2.PythonVersionResponse{PythonVersion: &newVersion}), nil).Once()
+		err := stack.WaitVerifyPythonVersionUpdate(context.TODO(), client, mockStack, mockPythonVersion)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				err := stack.WaitVerifyPythonVersionUpdate(context.TODO(), client, mockStack, mockPythonVersion)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitStackRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	required := true
+	mockStackInfo := genStackInfo(&required)
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, mockStackInfo), nil).Once()
+		stackInfo, err := stack.WaitStackRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, mockStackInfo, stackInfo)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, mockStackInfo), nil).Once()
+		stackInfo, err := stack.WaitStackRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, mockStackInfo, stackInfo)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				stackInfo, err := stack.WaitStackRead(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, stackInfo)
+			})
+		}
+	})
+}
+
+func Test_WaitRestartCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		err := stack.WaitRestartCreate(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusAccepted, `{"message":"restart initiated"}`), nil).Once()
+		err := stack.WaitRestartCreate(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(http.StatusAccepted, ""), nil).Once()
+		err := stack.WaitRestartCreate(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				err := stack.WaitRestartCreate(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyRequestedRestartComplete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	required, notRequired := true, false
+	restarting := stack.RestartStatuses{ShcStatus: []v2.RestartStatus{genRestartStatus(true, false)}}
+	ready := stack.RestartStatuses{ShcStatus: []v2.RestartStatus{genRestartStatus(false, true)}}
+
+	t.Run("with restart complete after it has been initiated", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, ready), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, restarting), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, restarting), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, ready), nil).Once()
+		restartStatuses, err := stack.WaitVerifyRequestedRestartComplete(context.TODO(), client, mockStack, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "sh-i-0123456789", stack.RestartCaptain(restartStatuses.ShcStatus))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with required restart no longer reported", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, ready), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, genStackInfo(&required)), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, ready), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, genStackInfo(&notRequired)), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, ready), nil).Once()
+		_, err := stack.WaitVerifyRequestedRestartComplete(context.TODO(), client, mockStack, true)
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRawResp(statusCode, ""), nil).Once()
+				_, err := stack.WaitVerifyRequestedRestartComplete(context.TODO(), client, mockStack, false)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -1,8 +1,10 @@
 package status
 
 import (
+	"encoding/json"
 	"errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
 	"io"
 	"net/http"
 	"strings"
@@ -65,3 +67,29 @@ func ProcessResponse(resp *http.Response, targetStateCodes []string, pendingStat
 	return resp, statusText, nil
 
 }
+
+// ProcessReadResponse unmarshals the body of a successful GET response into target, rate limited responses are returned with
+// target left empty and any other response is an unexpected state
+func ProcessReadResponse(resp *http.Response, target any) (any, string, error) {
+	if resp == nil {
+		return nil, "", &resource.UnexpectedStateError{LastError: errors.New("nil response")}
+	}
+
+	statusText := http.StatusText(resp.StatusCode)
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusTooManyRequests {
+		return nil, statusText, &resource.UnexpectedStateError{
+			State:         statusText,
+			ExpectedState: wait.TargetStatusResourceExists,
+			LastError:     errors.New(string(bodyBytes)),
+		}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if err := json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+	}
+	return target, statusText, nil
+}
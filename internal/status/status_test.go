@@ -146,3 +146,38 @@ func Test_ProcessResponse(t *testing.T) {
 		assert.NoError(err)
 	})
 }
+
+func Test_ProcessReadResponse(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("with nil response", func(_ *testing.T) {
+		var target v2.Error
+		resp, _, err := status.ProcessReadResponse(nil, &target)
+		assert.Nil(resp)
+		assert.Error(err)
+	})
+
+	t.Run("with http response 200", func(_ *testing.T) {
+		var target v2.Error
+		resp, statusText, err := status.ProcessReadResponse(genErrResp(http.StatusOK, "mock message"), &target)
+		assert.NoError(err)
+		assert.Equal(http.StatusText(http.StatusOK), statusText)
+		assert.Equal("mock message", resp.(*v2.Error).Message)
+	})
+
+	t.Run("with retryable response 429", func(_ *testing.T) {
+		var target v2.Error
+		resp, statusText, err := status.ProcessReadResponse(genErrResp(http.StatusTooManyRequests, "mock message"), &target)
+		assert.NoError(err)
+		assert.Equal(http.StatusText(http.StatusTooManyRequests), statusText)
+		assert.Empty(resp.(*v2.Error).Message)
+	})
+
+	t.Run("with unexpected http response", func(_ *testing.T) {
+		var target v2.Error
+		resp, statusText, err := status.ProcessReadResponse(genErrResp(http.StatusBadRequest, "mock message"), &target)
+		assert.Nil(resp)
+		assert.Equal(http.StatusText(http.StatusBadRequest), statusText)
+		assert.Error(err)
+	})
+}
@@ -1,8 +1,14 @@
 package utils
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
 	"sort"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -53,3 +59,117 @@ func GetSubnetsFromSet(subnets *schema.Set) []string {
 	}
 	return result
 }
+
+// ValidateIPv4Subnet is a schema validation function that only allows subnets in IPv4 CIDR notation without host bits
+func ValidateIPv4Subnet(v interface{}, path cty.Path) diag.Diagnostics {
+	return validateSubnet(v.(string), path, false)
+}
+
+// ValidateIPv6Subnet is a schema validation function that only allows subnets in IPv6 CIDR notation without host bits
+func ValidateIPv6Subnet(v interface{}, path cty.Path) diag.Diagnostics {
+	return validateSubnet(v.(string), path, true)
+}
+
+func validateSubnet(subnet string, path cty.Path, ipv6 bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	family, example := "IPv4", "10.0.0.0/24"
+	if ipv6 {
+		family, example = "IPv6", "2001:db8::/32"
+	}
+
+	prefix, err := netip.ParsePrefix(subnet)
+	if err != nil || prefix.Addr().Is6() != ipv6 || prefix.Addr().Is4In6() {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "invalid value",
+			Detail:        fmt.Sprintf("%q is not a valid %s subnet in CIDR notation, e.g. %s", subnet, family, example),
+			AttributePath: path,
+		})
+		return diags
+	}
+
+	if prefix.Masked() != prefix {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "invalid value",
+			Detail:        fmt.Sprintf("%q has host bits set, use the network address %q instead", subnet, prefix.Masked().String()),
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+// NormalizeSubnet returns the canonical form of a subnet in CIDR notation, subnets that can not be parsed are returned unchanged
+func NormalizeSubnet(subnet string) string {
+	prefix, err := netip.ParsePrefix(subnet)
+	if err != nil {
+		return subnet
+	}
+	return prefix.Masked().String()
+}
+
+// ContainsSubnet returns true if the subnets contain the subnet in any of its equivalent notations
+func ContainsSubnet(subnets []string, subnet string) bool {
+	for _, s := range subnets {
+		if NormalizeSubnet(s) == NormalizeSubnet(subnet) {
+			return true
+		}
+	}
+	return false
+}
+
+// SuppressEquivalentSubnet is a DiffSuppressFunc that suppresses the diff between equivalent notations of a subnet
+func SuppressEquivalentSubnet(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return NormalizeSubnet(oldValue) == NormalizeSubnet(newValue)
+}
+
+// HashSubnet hashes the canonical form of a subnet so that equivalent notations of a subnet do not cause a diff
+func HashSubnet(v interface{}) int {
+	return schema.HashString(NormalizeSubnet(v.(string)))
+}
+
+// ValidateSubnetsOverlap returns an error if any two of the subnets are duplicates or overlap each other
+func ValidateSubnetsOverlap(subnets []string) error {
+	prefixes := make([]netip.Prefix, 0, len(subnets))
+	for _, subnet := range subnets {
+		// malformed subnets are reported by the validation of the individual subnets
+		if prefix, err := netip.ParsePrefix(subnet); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+		}
+	}
+
+	for i := range prefixes {
+		for j := i + 1; j < len(prefixes); j++ {
+			if prefixes[i] == prefixes[j] {
+				return fmt.Errorf("subnet %s is listed more than once", prefixes[i])
+			}
+			if prefixes[i].Overlaps(prefixes[j]) {
+				return fmt.Errorf("subnets %s and %s overlap, remove the narrower subnet", prefixes[i], prefixes[j])
+			}
+		}
+	}
+	return nil
+}
+
+// CustomizeDiffSubnets returns a CustomizeDiffFunc that fails the plan if the subnets of the given key are duplicates or overlap each other
+func CustomizeDiffSubnets(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		if err := ValidateSubnetsOverlap(GetSubnetsFromSet(d.Get(key).(*schema.Set))); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+		return nil
+	}
+}
+
+// FlattenPolicy encodes a policy document as a JSON string. The keys of the policy are sorted when marshalled
+// so the JSON string is stable between reads
+func FlattenPolicy(policy map[string]interface{}) (string, error) {
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+	return string(policyJSON), nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/splunk/terraform-provider-scp/internal/utils"
 	"github.com/stretchr/testify/assert"
@@ -63,3 +64,58 @@ func Test_TestParseSetValues(t *testing.T) {
 	parsedSet := utils.ParseSetValues(values)
 	assert.ElementsMatch(t, parsedSet, testData)
 }
+
+func Test_ValidateIPv6Subnet(t *testing.T) {
+	for _, subnet := range []string{"2001:db8::/32", "2001:db8::1/128", "::/0"} {
+		t.Run(fmt.Sprintf("with valid subnet %s", subnet), func(t *testing.T) {
+			assert.False(t, utils.ValidateIPv6Subnet(subnet, cty.Path{}).HasError())
+		})
+	}
+
+	for _, subnet := range []string{"10.0.0.0/24", "::ffff:10.0.0.0/120", "2001:db8::", "2001:db8::/129", "2001:db8::1/64", "not-a-subnet"} {
+		t.Run(fmt.Sprintf("with invalid subnet %s", subnet), func(t *testing.T) {
+			assert.True(t, utils.ValidateIPv6Subnet(subnet, cty.Path{}).HasError())
+		})
+	}
+}
+
+func Test_ValidateIPv4Subnet(t *testing.T) {
+	for _, subnet := range []string{"10.0.0.0/24", "1.1.1.1/32", "0.0.0.0/0"} {
+		t.Run(fmt.Sprintf("with valid subnet %s", subnet), func(t *testing.T) {
+			assert.False(t, utils.ValidateIPv4Subnet(subnet, cty.Path{}).HasError())
+		})
+	}
+
+	for _, subnet := range []string{"10.0.0.5/24", "10.0.0.0", "10.0.0.0/33", "2001:db8::/32", "::ffff:10.0.0.0/120", "010.0.0.0/24"} {
+		t.Run(fmt.Sprintf("with invalid subnet %s", subnet), func(t *testing.T) {
+			assert.True(t, utils.ValidateIPv4Subnet(subnet, cty.Path{}).HasError())
+		})
+	}
+}
+
+func Test_NormalizeSubnet(t *testing.T) {
+	assert.Equal(t, "2001:db8::/64", utils.NormalizeSubnet("2001:DB8:0:0::/64"))
+	assert.Equal(t, "10.0.0.0/24", utils.NormalizeSubnet("10.0.0.0/24"))
+	assert.Equal(t, "not-a-subnet", utils.NormalizeSubnet("not-a-subnet"))
+	assert.Equal(t, utils.HashSubnet("2001:db8::/64"), utils.HashSubnet("2001:0db8:0000::/64"))
+	assert.True(t, utils.SuppressEquivalentSubnet("", "2001:db8::/64", "2001:DB8:0::/64", nil))
+	assert.False(t, utils.SuppressEquivalentSubnet("", "10.0.0.0/24", "10.0.1.0/24", nil))
+	assert.True(t, utils.ContainsSubnet([]string{"10.0.0.0/24", "2001:db8::/64"}, "2001:DB8::/64"))
+	assert.False(t, utils.ContainsSubnet([]string{"10.0.0.0/24"}, "10.0.1.0/24"))
+}
+
+func Test_ValidateSubnetsOverlap(t *testing.T) {
+	assert.NoError(t, utils.ValidateSubnetsOverlap([]string{"10.0.0.0/24", "10.0.1.0/24", "2001:db8::/64"}))
+	assert.ErrorContains(t, utils.ValidateSubnetsOverlap([]string{"2001:db8::/64", "2001:DB8::/64"}), "more than once")
+	assert.ErrorContains(t, utils.ValidateSubnetsOverlap([]string{"10.0.0.0/16", "10.0.1.0/24"}), "overlap")
+	assert.NoError(t, utils.ValidateSubnetsOverlap([]string{"not-a-subnet", "10.0.0.0/24"}))
+}
+
+func Test_FlattenPolicy(t *testing.T) {
+	policy, err := utils.FlattenPolicy(map[string]interface{}{"Version": "2012-10-17", "Statement": []interface{}{"allow"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Statement":["allow"],"Version":"2012-10-17"}`, policy)
+
+	_, err = utils.FlattenPolicy(map[string]interface{}{"invalid": make(chan int)})
+	assert.Error(t, err)
+}